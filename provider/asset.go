@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"unicode"
 
 	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
 	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Asset struct{}
@@ -42,6 +44,15 @@ type AssetArgs struct {
 	ExternalLinks []ExternalLink              `pulumi:"externalLinks,optional"`
 	Sources       []AssetSource               `pulumi:"sources,optional"`
 	Environments  map[string]AssetEnvironment `pulumi:"environments,optional"`
+
+	IgnoreMetadataKeys []string `pulumi:"ignoreMetadataKeys,optional"`
+	// SchemaFile is read during Check and replaces schema with its normalized
+	// contents.
+	SchemaFile   *string `pulumi:"schemaFile,optional"`
+	SchemaFormat *string `pulumi:"schemaFormat,optional"`
+	// SchemaMessage is the fully qualified name of the Protobuf message
+	// described by the asset.
+	SchemaMessage *string `pulumi:"schemaMessage,optional"`
 }
 
 type AssetState struct {
@@ -126,9 +137,28 @@ func (Asset) Diff(ctx context.Context, id string, olds AssetState, news AssetArg
 		hasChanges = true
 	}
 
-	// Compare metadata with normalization
-	oldMeta := normalizeMapValues(olds.Metadata)
-	newMeta := normalizeMapValues(news.Metadata)
+	if !reflect.DeepEqual(olds.IgnoreMetadataKeys, news.IgnoreMetadataKeys) {
+		detailedDiff["ignoreMetadataKeys"] = p.PropertyDiff{Kind: p.Update}
+		hasChanges = true
+	}
+	if stringValue(olds.SchemaFile) != stringValue(news.SchemaFile) {
+		detailedDiff["schemaFile"] = p.PropertyDiff{Kind: p.Update}
+		hasChanges = true
+	}
+	if stringValue(olds.SchemaFormat) != stringValue(news.SchemaFormat) {
+		detailedDiff["schemaFormat"] = p.PropertyDiff{Kind: p.Update}
+		hasChanges = true
+	}
+	if stringValue(olds.SchemaMessage) != stringValue(news.SchemaMessage) {
+		detailedDiff["schemaMessage"] = p.PropertyDiff{Kind: p.Update}
+		hasChanges = true
+	}
+
+	// Compare metadata with normalization, leaving out server-managed and
+	// provenance keys
+	ignored := hiddenMetadataPatterns(infer.GetConfig[Config](ctx), news)
+	oldMeta := normalizeMapValues(withoutIgnoredMetadata(olds.Metadata, ignored))
+	newMeta := normalizeMapValues(withoutIgnoredMetadata(news.Metadata, ignored))
 	if !reflect.DeepEqual(oldMeta, newMeta) {
 		for k := range oldMeta {
 			if _, exists := newMeta[k]; !exists {
@@ -144,21 +174,30 @@ func (Asset) Diff(ctx context.Context, id string, olds AssetState, news AssetArg
 		}
 	}
 
-	// Compare schema
-	if !reflect.DeepEqual(normalizeMapValues(olds.Schema), normalizeMapValues(news.Schema)) {
-		detailedDiff["schema"] = p.PropertyDiff{Kind: p.Update}
-		hasChanges = true
+	// Compare schema, sources and environments field by field so that a change
+	// deep inside a large schema is reported at its own path.
+	nestedDiff := map[string]p.PropertyDiff{}
+	diffNested("schema", normalizeMapValues(olds.Schema), normalizeMapValues(news.Schema), nestedDiff)
+	diffNested("sources", sourcesToArray(normalizeSources(olds.Sources)), sourcesToArray(normalizeSources(news.Sources)), nestedDiff)
+
+	oldEnvs := normalizeEnvironments(olds.Environments)
+	newEnvs := normalizeEnvironments(news.Environments)
+	for k, v := range oldEnvs {
+		path := fmt.Sprintf("environments[%q]", k)
+		if nv, exists := newEnvs[k]; exists {
+			diffNested(path, v, nv, nestedDiff)
+		} else {
+			nestedDiff[path] = p.PropertyDiff{Kind: p.Delete}
+		}
 	}
-
-	// Compare sources and environments
-	if !reflect.DeepEqual(normalizeSources(olds.Sources), normalizeSources(news.Sources)) {
-		detailedDiff["sources"] = p.PropertyDiff{Kind: p.Update}
-		hasChanges = true
+	for k := range newEnvs {
+		if _, exists := oldEnvs[k]; !exists {
+			nestedDiff[fmt.Sprintf("environments[%q]", k)] = p.PropertyDiff{Kind: p.Add}
+		}
 	}
 
-	if !reflect.DeepEqual(normalizeEnvironments(olds.Environments), normalizeEnvironments(news.Environments)) &&
-		!(len(olds.Environments) == 0 && len(news.Environments) == 0) {
-		detailedDiff["environments"] = p.PropertyDiff{Kind: p.Update}
+	for k, v := range nestedDiff {
+		detailedDiff[k] = v
 		hasChanges = true
 	}
 
@@ -169,6 +208,106 @@ func (Asset) Diff(ctx context.Context, id string, olds AssetState, news AssetArg
 	}, nil
 }
 
+// diffNested walks two normalized values in parallel and records an Add, Delete
+// or Update entry for every leaf that differs, using Pulumi property paths such
+// as schema.fields[3].type.
+func diffNested(path string, olds, news interface{}, diff map[string]p.PropertyDiff) {
+	oldEmpty, newEmpty := isEmptyValue(olds), isEmptyValue(news)
+	switch {
+	case oldEmpty && newEmpty:
+		return
+	case oldEmpty:
+		diff[path] = p.PropertyDiff{Kind: p.Add}
+		return
+	case newEmpty:
+		diff[path] = p.PropertyDiff{Kind: p.Delete}
+		return
+	}
+
+	switch o := olds.(type) {
+	case map[string]interface{}:
+		if n, ok := news.(map[string]interface{}); ok {
+			for k, ov := range o {
+				if nv, exists := n[k]; exists {
+					diffNested(propertyPath(path, k), ov, nv, diff)
+				} else {
+					diff[propertyPath(path, k)] = p.PropertyDiff{Kind: p.Delete}
+				}
+			}
+			for k := range n {
+				if _, exists := o[k]; !exists {
+					diff[propertyPath(path, k)] = p.PropertyDiff{Kind: p.Add}
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if n, ok := news.([]interface{}); ok {
+			for i := 0; i < len(o) || i < len(n); i++ {
+				elemPath := fmt.Sprintf("%s[%d]", path, i)
+				switch {
+				case i >= len(n):
+					diff[elemPath] = p.PropertyDiff{Kind: p.Delete}
+				case i >= len(o):
+					diff[elemPath] = p.PropertyDiff{Kind: p.Add}
+				default:
+					diffNested(elemPath, o[i], n[i], diff)
+				}
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(olds, news) {
+		diff[path] = p.PropertyDiff{Kind: p.Update}
+	}
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(val) == 0
+	case []interface{}:
+		return len(val) == 0
+	}
+	return false
+}
+
+// propertyPath appends a map key to a property path, quoting keys that are
+// not plain identifiers.
+func propertyPath(path, key string) string {
+	if isIdentifier(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s[%q]", path, key)
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func sourcesToArray(sources []map[string]interface{}) []interface{} {
+	if sources == nil {
+		return nil
+	}
+	result := make([]interface{}, len(sources))
+	for i, s := range sources {
+		result[i] = s
+	}
+	return result
+}
+
 func normalizeSources(sources []AssetSource) []map[string]interface{} {
 	if sources == nil {
 		return nil
@@ -258,9 +397,50 @@ func environmentsEqual(a, b map[string]AssetEnvironment) bool {
 	return true
 }
 
+func (Asset) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (AssetArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[AssetArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	args, failures = loadAssetSchema(args)
+	if len(failures) > 0 {
+		return args, failures, nil
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	args = applyAssetDefaults(config, args)
+	failures, err = checkTagVocabulary(ctx, config, args.Tags)
+	return args, failures, err
+}
+
+// applyAssetDefaults merges the provider's default tags and metadata into the
+// asset arguments. Values set on the asset itself take precedence.
+func applyAssetDefaults(config Config, args AssetArgs) AssetArgs {
+	for _, tag := range config.DefaultTags {
+		if !containsString(args.Tags, tag) {
+			args.Tags = append(args.Tags, tag)
+		}
+	}
+
+	if len(config.DefaultMetadata) > 0 {
+		metadata := make(map[string]interface{}, len(config.DefaultMetadata)+len(args.Metadata))
+		for k, v := range config.DefaultMetadata {
+			metadata[k] = v
+		}
+		for k, v := range args.Metadata {
+			metadata[k] = v
+		}
+		args.Metadata = metadata
+	}
+
+	return args
+}
+
 func (Asset) Create(ctx context.Context, name string, input AssetArgs, preview bool) (string, AssetState, error) {
 	state := AssetState{AssetArgs: input}
 	if preview {
+		state.MRN = assetMRN(input.Type, input.Providers, input.Name)
 		return name, state, nil
 	}
 
@@ -275,14 +455,30 @@ func (Asset) Create(ctx context.Context, name string, input AssetArgs, preview b
 		return "", state, err
 	}
 
-	params := assets.NewPostAssetsParams().WithAsset(asset)
-	result, err := client.Assets.PostAssets(params)
+	if config.Provenance {
+		metadata, _ := asset.Metadata.(map[string]interface{})
+		asset.Metadata = withProvenance(ctx, metadata)
+	}
+
+	created, err := createAsset(ctx, config, client.Assets, asset)
 	if err != nil {
 		return "", state, err
 	}
 
-	state = parseToAssetState(result.Payload)
-	return result.Payload.ID, state, nil
+	state = parseToAssetState(created)
+	state.IgnoreMetadataKeys = input.IgnoreMetadataKeys
+	state.SchemaFile, state.SchemaFormat = input.SchemaFile, input.SchemaFormat
+	state.SchemaMessage = input.SchemaMessage
+	state.Metadata = withoutIgnoredMetadata(state.Metadata, hiddenMetadataPatterns(config, input))
+	return created.ID, state, nil
+}
+
+// WireDependencies marks the MRN as known whenever it could be derived, so that
+// resources referencing it, such as Lineage, have a concrete value in preview.
+func (Asset) WireDependencies(f infer.FieldSelector, args *AssetArgs, state *AssetState) {
+	if state.MRN != "" {
+		f.OutputField(&state.MRN).AlwaysKnown()
+	}
 }
 
 func (Asset) Read(ctx context.Context, id string, inputs AssetArgs, state AssetState) (string, AssetArgs, AssetState, error) {
@@ -299,11 +495,23 @@ func (Asset) Read(ctx context.Context, id string, inputs AssetArgs, state AssetS
 	}
 
 	newState := parseToAssetState(result.Payload)
+	newState.IgnoreMetadataKeys = inputs.IgnoreMetadataKeys
+	newState.SchemaFile, newState.SchemaFormat = inputs.SchemaFile, inputs.SchemaFormat
+	newState.SchemaMessage = inputs.SchemaMessage
+	newState.Metadata = withoutIgnoredMetadata(newState.Metadata, hiddenMetadataPatterns(config, inputs))
 	return id, inputs, newState, nil
 }
 
 func (Asset) Update(ctx context.Context, id string, olds AssetState, news AssetArgs, preview bool) (AssetState, error) {
+	if len(news.Providers) == 0 {
+		news.Providers = olds.Providers
+	}
+
 	if preview {
+		mrn := olds.MRN
+		if assetMRN(olds.Type, olds.Providers, olds.Name) != assetMRN(news.Type, news.Providers, news.Name) {
+			mrn = assetMRN(news.Type, news.Providers, news.Name)
+		}
 		return AssetState{
 			AssetArgs:  news,
 			ResourceID: olds.ResourceID,
@@ -311,14 +519,10 @@ func (Asset) Update(ctx context.Context, id string, olds AssetState, news AssetA
 			CreatedBy:  olds.CreatedBy,
 			UpdatedAt:  olds.UpdatedAt,
 			LastSyncAt: olds.LastSyncAt,
-			MRN:        olds.MRN,
+			MRN:        mrn,
 		}, nil
 	}
 
-	if len(news.Providers) == 0 {
-		news.Providers = olds.Providers
-	}
-
 	asset, err := parseToAsset(news)
 	if err != nil {
 		return AssetState{}, err
@@ -330,13 +534,27 @@ func (Asset) Update(ctx context.Context, id string, olds AssetState, news AssetA
 		return AssetState{}, err
 	}
 
+	metadata := news.Metadata
+	ignored := ignoredMetadataPatterns(config, news)
+	if len(ignored) > 0 {
+		current, err := client.Assets.GetAssetsID(assets.NewGetAssetsIDParams().WithID(id))
+		if err != nil {
+			return AssetState{}, err
+		}
+		currentMeta, _ := current.Payload.Metadata.(map[string]interface{})
+		metadata = preserveIgnoredMetadata(news.Metadata, currentMeta, ignored)
+	}
+	if config.Provenance {
+		metadata = withProvenance(ctx, metadata)
+	}
+
 	params := assets.NewPutAssetsIDParams().WithID(id).WithAsset(&models.AssetsUpdateRequest{
 		Name:          news.Name,
 		Type:          news.Type,
 		Description:   news.Description,
 		Providers:     news.Providers,
 		Tags:          news.Tags,
-		Metadata:      news.Metadata,
+		Metadata:      metadata,
 		Schema:        news.Schema,
 		ExternalLinks: convertExternalLinks(news.ExternalLinks),
 		Sources:       asset.Sources,
@@ -348,7 +566,12 @@ func (Asset) Update(ctx context.Context, id string, olds AssetState, news AssetA
 		return AssetState{}, err
 	}
 
-	return parseToAssetState(result.Payload), nil
+	state := parseToAssetState(result.Payload)
+	state.IgnoreMetadataKeys = news.IgnoreMetadataKeys
+	state.SchemaFile, state.SchemaFormat = news.SchemaFile, news.SchemaFormat
+	state.SchemaMessage = news.SchemaMessage
+	state.Metadata = withoutIgnoredMetadata(state.Metadata, hiddenMetadataPatterns(config, news))
+	return state, nil
 }
 
 func (Asset) Delete(ctx context.Context, id string, state AssetState) error {
@@ -504,6 +727,15 @@ func convertModelExternalLinks(links []*models.AssetExternalLink) []ExternalLink
 	return result
 }
 
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 func stringValue(s *string) string {
 	if s == nil {
 		return ""
@@ -511,6 +743,13 @@ func stringValue(s *string) string {
 	return *s
 }
 
+func intValue(i *int, def int) int {
+	if i == nil {
+		return def
+	}
+	return *i
+}
+
 func int64Value(i *int64) int64 {
 	if i == nil {
 		return 0
@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// AssetCollection manages many assets as a single resource, applying them
+// through the batch API. Each asset is identified by a stable key.
+type AssetCollection struct{}
+
+type AssetCollectionItem struct {
+	Name          string                      `pulumi:"name"`
+	Type          string                      `pulumi:"type"`
+	Description   string                      `pulumi:"description,optional"`
+	Providers     []string                    `pulumi:"services"`
+	Tags          []string                    `pulumi:"tags,optional"`
+	Metadata      map[string]interface{}      `pulumi:"metadata,optional"`
+	Schema        map[string]interface{}      `pulumi:"schema,optional"`
+	ExternalLinks []ExternalLink              `pulumi:"externalLinks,optional"`
+	Sources       []AssetSource               `pulumi:"sources,optional"`
+	Environments  map[string]AssetEnvironment `pulumi:"environments,optional"`
+
+	IgnoreMetadataKeys []string `pulumi:"ignoreMetadataKeys,optional"`
+}
+
+type AssetCollectionArgs struct {
+	Assets map[string]AssetCollectionItem `pulumi:"assets"`
+	Config map[string]interface{}         `pulumi:"config,optional"`
+}
+
+type AssetCollectionState struct {
+	AssetCollectionArgs
+	MRNs        map[string]string `pulumi:"mrns"`
+	ResourceIDs map[string]string `pulumi:"resourceIds"`
+}
+
+func (i AssetCollectionItem) assetArgs() AssetArgs {
+	return AssetArgs{
+		Name:          i.Name,
+		Type:          i.Type,
+		Description:   i.Description,
+		Providers:     i.Providers,
+		Tags:          i.Tags,
+		Metadata:      i.Metadata,
+		Schema:        i.Schema,
+		ExternalLinks: i.ExternalLinks,
+		Sources:       i.Sources,
+		Environments:  i.Environments,
+
+		IgnoreMetadataKeys: i.IgnoreMetadataKeys,
+	}
+}
+
+func assetCollectionItem(args AssetArgs) AssetCollectionItem {
+	return AssetCollectionItem{
+		Name:          args.Name,
+		Type:          args.Type,
+		Description:   args.Description,
+		Providers:     args.Providers,
+		Tags:          args.Tags,
+		Metadata:      args.Metadata,
+		Schema:        args.Schema,
+		ExternalLinks: args.ExternalLinks,
+		Sources:       args.Sources,
+		Environments:  args.Environments,
+
+		IgnoreMetadataKeys: args.IgnoreMetadataKeys,
+	}
+}
+
+func (AssetCollection) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (AssetCollectionArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[AssetCollectionArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	var tags []string
+	for key, item := range args.Assets {
+		item = assetCollectionItem(applyAssetDefaults(config, item.assetArgs()))
+		args.Assets[key] = item
+		for _, tag := range item.Tags {
+			if !containsString(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	sort.Strings(tags)
+	failures, err = checkTagVocabulary(ctx, config, tags)
+	return args, failures, err
+}
+
+// Diff reports each asset at its own path, such as assets["orders"].schema,
+// reusing the Asset resource's field-level comparison.
+func (AssetCollection) Diff(ctx context.Context, id string, olds AssetCollectionState, news AssetCollectionArgs) (p.DiffResponse, error) {
+	detailedDiff := map[string]p.PropertyDiff{}
+	if !reflect.DeepEqual(normalizeMapValues(olds.Config), normalizeMapValues(news.Config)) {
+		detailedDiff["config"] = p.PropertyDiff{Kind: p.Update}
+	}
+
+	for key := range olds.Assets {
+		if _, exists := news.Assets[key]; !exists {
+			detailedDiff[fmt.Sprintf("assets[%q]", key)] = p.PropertyDiff{Kind: p.Delete}
+		}
+	}
+	for key, item := range news.Assets {
+		path := fmt.Sprintf("assets[%q]", key)
+		old, exists := olds.Assets[key]
+		if !exists {
+			detailedDiff[path] = p.PropertyDiff{Kind: p.Add}
+			continue
+		}
+
+		diff, err := Asset{}.Diff(ctx, "", AssetState{AssetArgs: old.assetArgs()}, item.assetArgs())
+		if err != nil {
+			return p.DiffResponse{}, err
+		}
+		for k, v := range diff.DetailedDiff {
+			detailedDiff[path+"."+k] = v
+		}
+	}
+
+	return p.DiffResponse{
+		HasChanges:   len(detailedDiff) > 0,
+		DetailedDiff: detailedDiff,
+	}, nil
+}
+
+func (AssetCollection) Create(ctx context.Context, name string, input AssetCollectionArgs, preview bool) (string, AssetCollectionState, error) {
+	state := AssetCollectionState{
+		AssetCollectionArgs: AssetCollectionArgs{Assets: map[string]AssetCollectionItem{}, Config: input.Config},
+		MRNs:                map[string]string{},
+		ResourceIDs:         map[string]string{},
+	}
+	if preview {
+		state.Assets = input.Assets
+		for key, item := range input.Assets {
+			if mrn := assetMRN(item.Type, item.Providers, item.Name); mrn != "" {
+				state.MRNs[key] = mrn
+			}
+		}
+		return name, state, nil
+	}
+
+	err := applyAssetCollection(ctx, &state, input.Assets, sortedKeys(input.Assets))
+	return name, state, err
+}
+
+func (AssetCollection) Update(ctx context.Context, id string, olds AssetCollectionState, news AssetCollectionArgs, preview bool) (AssetCollectionState, error) {
+	state := AssetCollectionState{
+		AssetCollectionArgs: AssetCollectionArgs{Assets: map[string]AssetCollectionItem{}, Config: news.Config},
+		MRNs:                map[string]string{},
+		ResourceIDs:         map[string]string{},
+	}
+
+	// A changed plugin config is sent with every asset, so all of them are
+	// applied again.
+	configChanged := !reflect.DeepEqual(normalizeMapValues(olds.Config), normalizeMapValues(news.Config))
+	var changed []string
+	for _, key := range sortedKeys(news.Assets) {
+		old, exists := olds.Assets[key]
+		if exists && !configChanged {
+			diff, err := Asset{}.Diff(ctx, "", AssetState{AssetArgs: old.assetArgs()}, news.Assets[key].assetArgs())
+			if err != nil {
+				return state, err
+			}
+			if !diff.HasChanges {
+				state.Assets[key] = news.Assets[key]
+				state.MRNs[key] = olds.MRNs[key]
+				state.ResourceIDs[key] = olds.ResourceIDs[key]
+				continue
+			}
+		}
+		changed = append(changed, key)
+	}
+
+	if preview {
+		for _, key := range changed {
+			item := news.Assets[key]
+			state.Assets[key] = item
+			if mrn := assetMRN(item.Type, item.Providers, item.Name); mrn != "" {
+				state.MRNs[key] = mrn
+			}
+		}
+		return state, nil
+	}
+
+	// Keep the previous definitions so that assets which fail to apply are
+	// retried on the next update.
+	for _, key := range changed {
+		if old, exists := olds.Assets[key]; exists {
+			state.Assets[key] = old
+			state.MRNs[key] = olds.MRNs[key]
+			state.ResourceIDs[key] = olds.ResourceIDs[key]
+		}
+	}
+	err := applyAssetCollection(ctx, &state, news.Assets, changed)
+	var failed infer.ResourceInitFailedError
+	if err != nil && !errors.As(err, &failed) {
+		return state, err
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, clientErr := config.GetClient()
+	if clientErr != nil {
+		return state, clientErr
+	}
+
+	// Remove assets whose key was dropped, and the previous asset of any key
+	// whose name, type or service changed its MRN.
+	var stale []string
+	for key, resourceID := range olds.ResourceIDs {
+		if _, exists := news.Assets[key]; !exists {
+			stale = append(stale, key)
+		} else if state.ResourceIDs[key] != resourceID {
+			if err := deleteAsset(ctx, client.Assets, resourceID); err != nil {
+				failed.Reasons = append(failed.Reasons, fmt.Sprintf("deleting previous asset for %q: %s", key, err))
+			}
+		}
+	}
+	sort.Strings(stale)
+	for _, key := range stale {
+		if err := deleteAsset(ctx, client.Assets, olds.ResourceIDs[key]); err != nil {
+			failed.Reasons = append(failed.Reasons, fmt.Sprintf("deleting asset %q: %s", key, err))
+			state.Assets[key] = olds.Assets[key]
+			state.MRNs[key] = olds.MRNs[key]
+			state.ResourceIDs[key] = olds.ResourceIDs[key]
+		}
+	}
+
+	if len(failed.Reasons) > 0 {
+		return state, failed
+	}
+	return state, nil
+}
+
+func (AssetCollection) Read(ctx context.Context, id string, inputs AssetCollectionArgs, state AssetCollectionState) (string, AssetCollectionArgs, AssetCollectionState, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return "", inputs, state, err
+	}
+
+	newState := AssetCollectionState{
+		AssetCollectionArgs: AssetCollectionArgs{Assets: map[string]AssetCollectionItem{}, Config: state.Config},
+		MRNs:                map[string]string{},
+		ResourceIDs:         map[string]string{},
+	}
+	for _, key := range sortedKeys(state.ResourceIDs) {
+		result, err := client.Assets.GetAssetsID(assets.NewGetAssetsIDParamsWithContext(ctx).WithID(state.ResourceIDs[key]))
+		if err != nil {
+			var notFound *assets.GetAssetsIDNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return "", inputs, state, fmt.Errorf("reading asset %q: %w", key, err)
+		}
+
+		asset := parseToAssetState(result.Payload)
+		asset.IgnoreMetadataKeys = state.Assets[key].IgnoreMetadataKeys
+		asset.Metadata = withoutIgnoredMetadata(asset.Metadata, hiddenMetadataPatterns(config, asset.AssetArgs))
+		newState.Assets[key] = assetCollectionItem(asset.AssetArgs)
+		newState.MRNs[key] = asset.MRN
+		newState.ResourceIDs[key] = asset.ResourceID
+	}
+	return id, inputs, newState, nil
+}
+
+func (AssetCollection) Delete(ctx context.Context, id string, state AssetCollectionState) error {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(state.ResourceIDs) {
+		if err := deleteAsset(ctx, client.Assets, state.ResourceIDs[key]); err != nil {
+			errs = append(errs, fmt.Errorf("deleting asset %q: %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyAssetCollection sends the assets for keys to PostAssetsBatch in chunks
+// and records each successfully applied asset in state. Existing assets keep
+// the server's values for their ignored metadata keys. Per-asset failures are
+// returned together as an infer.ResourceInitFailedError.
+func applyAssetCollection(ctx context.Context, state *AssetCollectionState, items map[string]AssetCollectionItem, keys []string) error {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return err
+	}
+
+	size := max(1, intValue(config.BatchSize, defaultBatchSize))
+	var reasons []string
+	for start := 0; start < len(keys); start += size {
+		request := &models.AssetsBatchCreateRequest{}
+		if state.Config != nil {
+			request.Config = normalizeMap(state.Config)
+		}
+		var chunk []string
+		for _, key := range keys[start:min(start+size, len(keys))] {
+			args := items[key].assetArgs()
+			if ignored := ignoredMetadataPatterns(config, args); len(ignored) > 0 && state.ResourceIDs[key] != "" {
+				current, err := client.Assets.GetAssetsID(assets.NewGetAssetsIDParamsWithContext(ctx).WithID(state.ResourceIDs[key]))
+				if err != nil {
+					reasons = append(reasons, fmt.Sprintf("applying asset %q: %s", key, err))
+					continue
+				}
+				currentMeta, _ := current.Payload.Metadata.(map[string]interface{})
+				args.Metadata = preserveIgnoredMetadata(args.Metadata, currentMeta, ignored)
+			}
+			asset, err := parseToAsset(args)
+			if err != nil {
+				return err
+			}
+			if config.Provenance {
+				metadata, _ := asset.Metadata.(map[string]interface{})
+				asset.Metadata = withProvenance(ctx, metadata)
+			}
+
+			// The batch endpoint upserts, so an asset this collection does not
+			// own yet is created on its own if it may already exist, where the
+			// API rejects it instead of overwriting it.
+			if !ownsAsset(*state, key, args) {
+				params := assets.NewGetAssetsLookupTypeNameParamsWithContext(ctx).WithType(args.Type).WithName(args.Name)
+				existing, err := client.Assets.GetAssetsLookupTypeName(params)
+				var notFound *assets.GetAssetsLookupTypeNameNotFound
+				if err != nil && !errors.As(err, &notFound) {
+					reasons = append(reasons, fmt.Sprintf("applying asset %q: looking up asset: %s", key, err))
+					continue
+				}
+				if err == nil && existing.Payload.ID != state.ResourceIDs[key] {
+					created, err := postAsset(client.Assets, asset)
+					if err != nil {
+						reasons = append(reasons, fmt.Sprintf("applying asset %q: %s", key, err))
+						continue
+					}
+					state.Assets[key] = items[key]
+					state.MRNs[key] = created.Mrn
+					state.ResourceIDs[key] = created.ID
+					continue
+				}
+			}
+			chunk = append(chunk, key)
+			request.Assets = append(request.Assets, asset)
+		}
+		if len(chunk) == 0 {
+			continue
+		}
+
+		result, err := client.Assets.PostAssetsBatch(assets.NewPostAssetsBatchParamsWithContext(ctx).WithRequest(request))
+		if err == nil && len(result.Payload.Assets) != len(chunk) {
+			err = fmt.Errorf("batch create returned %d results for %d assets", len(result.Payload.Assets), len(chunk))
+		}
+		if err != nil {
+			for _, key := range chunk {
+				reasons = append(reasons, fmt.Sprintf("applying asset %q: %s", key, err))
+			}
+			continue
+		}
+
+		for i, key := range chunk {
+			applied := parseBatchAssetResult(request.Assets[i], result.Payload.Assets[i])
+			if applied.err != nil {
+				reasons = append(reasons, fmt.Sprintf("applying asset %q: %s", key, applied.err))
+				continue
+			}
+			// An asset created by someone else since it was looked up is
+			// reported rather than adopted, so that it is never deleted.
+			if status := result.Payload.Assets[i].Status; status != assetStatusCreated && applied.asset.ID != state.ResourceIDs[key] {
+				reasons = append(reasons, fmt.Sprintf("applying asset %q: asset %s already exists (status %q), import it instead", key, applied.asset.ID, status))
+				continue
+			}
+			state.Assets[key] = items[key]
+			state.MRNs[key] = applied.asset.Mrn
+			state.ResourceIDs[key] = applied.asset.ID
+		}
+	}
+
+	if len(reasons) > 0 {
+		return infer.ResourceInitFailedError{Reasons: reasons}
+	}
+	return nil
+}
+
+// ownsAsset reports whether the asset for key was applied by this collection
+// with the same name, type and services, so that the upsert updates it.
+func ownsAsset(state AssetCollectionState, key string, args AssetArgs) bool {
+	old, exists := state.Assets[key]
+	return exists && state.ResourceIDs[key] != "" &&
+		old.Name == args.Name && old.Type == args.Type && reflect.DeepEqual(old.Providers, args.Providers)
+}
+
+func deleteAsset(ctx context.Context, client assets.ClientService, id string) error {
+	_, err := client.DeleteAssetsID(assets.NewDeleteAssetsIDParamsWithContext(ctx).WithID(id))
+	var notFound *assets.DeleteAssetsIDNotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,492 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"golang.org/x/sync/errgroup"
+)
+
+// CatalogAsset is an asset as stored in the Marmot catalog, returned by the
+// functions that read assets not necessarily managed by this program.
+type CatalogAsset struct {
+	ResourceID    string                      `pulumi:"resourceId"`
+	MRN           string                      `pulumi:"mrn"`
+	Name          string                      `pulumi:"name"`
+	Type          string                      `pulumi:"type"`
+	Description   string                      `pulumi:"description,optional"`
+	Providers     []string                    `pulumi:"services"`
+	Tags          []string                    `pulumi:"tags,optional"`
+	Metadata      map[string]interface{}      `pulumi:"metadata,optional"`
+	Schema        map[string]interface{}      `pulumi:"schema,optional"`
+	ExternalLinks []ExternalLink              `pulumi:"externalLinks,optional"`
+	Sources       []AssetSource               `pulumi:"sources,optional"`
+	Environments  map[string]AssetEnvironment `pulumi:"environments,optional"`
+	ParentMRN     string                      `pulumi:"parentMrn,optional"`
+	CreatedAt     string                      `pulumi:"createdAt"`
+	CreatedBy     string                      `pulumi:"createdBy"`
+	UpdatedAt     string                      `pulumi:"updatedAt"`
+	LastSyncAt    string                      `pulumi:"lastSyncAt,optional"`
+}
+
+func parseToCatalogAsset(asset *models.AssetAsset) CatalogAsset {
+	state := parseToAssetState(asset)
+	return CatalogAsset{
+		ResourceID:    asset.ID,
+		MRN:           asset.Mrn,
+		Name:          state.Name,
+		Type:          state.Type,
+		Description:   state.Description,
+		Providers:     state.Providers,
+		Tags:          state.Tags,
+		Metadata:      state.Metadata,
+		Schema:        state.Schema,
+		ExternalLinks: state.ExternalLinks,
+		Sources:       state.Sources,
+		Environments:  state.Environments,
+		ParentMRN:     asset.ParentMrn,
+		CreatedAt:     asset.CreatedAt,
+		CreatedBy:     asset.CreatedBy,
+		UpdatedAt:     asset.UpdatedAt,
+		LastSyncAt:    asset.LastSyncAt,
+	}
+}
+
+// GetCatalogAsset reads an asset by ID or MRN. It is not named getAsset because
+// the Go SDK would name that function LookupAsset, clashing with lookupAsset.
+type GetCatalogAsset struct{}
+
+type GetCatalogAssetArgs struct {
+	ResourceID *string `pulumi:"resourceId,optional"`
+	MRN        *string `pulumi:"mrn,optional"`
+}
+
+type GetCatalogAssetResult struct {
+	CatalogAsset
+}
+
+func (GetCatalogAsset) Call(ctx context.Context, args GetCatalogAssetArgs) (GetCatalogAssetResult, error) {
+	if (args.ResourceID == nil) == (args.MRN == nil) {
+		return GetCatalogAssetResult{}, fmt.Errorf("exactly one of resourceId or mrn must be set")
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetCatalogAssetResult{}, err
+	}
+
+	if args.ResourceID != nil {
+		params := assets.NewGetAssetsIDParamsWithContext(ctx).WithID(*args.ResourceID)
+		result, err := client.Assets.GetAssetsID(params)
+		if err != nil {
+			return GetCatalogAssetResult{}, fmt.Errorf("reading asset %q: %w", *args.ResourceID, err)
+		}
+		return GetCatalogAssetResult{parseToCatalogAsset(result.Payload)}, nil
+	}
+
+	params := assets.NewGetAssetsQualifiedNameQualifiedNameParamsWithContext(ctx).WithQualifiedName(*args.MRN)
+	result, err := client.Assets.GetAssetsQualifiedNameQualifiedName(params)
+	if err != nil {
+		return GetCatalogAssetResult{}, fmt.Errorf("reading asset %q: %w", *args.MRN, err)
+	}
+	return GetCatalogAssetResult{parseToCatalogAsset(result.Payload)}, nil
+}
+
+type LookupAsset struct{}
+
+type LookupAssetArgs struct {
+	Type         string `pulumi:"type"`
+	Name         string `pulumi:"name"`
+	AllowMissing *bool  `pulumi:"allowMissing,optional"`
+}
+
+// LookupAssetResult holds the matching asset, which is null when allowMissing
+// is set and nothing matches.
+type LookupAssetResult struct {
+	Asset *CatalogAsset `pulumi:"asset,optional"`
+}
+
+func (LookupAsset) Call(ctx context.Context, args LookupAssetArgs) (LookupAssetResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return LookupAssetResult{}, err
+	}
+
+	params := assets.NewGetAssetsLookupTypeNameParamsWithContext(ctx).WithType(args.Type).WithName(args.Name)
+	result, err := client.Assets.GetAssetsLookupTypeName(params)
+	if notFound := (*assets.GetAssetsLookupTypeNameNotFound)(nil); errors.As(err, &notFound) {
+		if args.AllowMissing != nil && *args.AllowMissing {
+			return LookupAssetResult{}, nil
+		}
+		return LookupAssetResult{}, fmt.Errorf("no asset of type %q named %q exists in the catalog", args.Type, args.Name)
+	}
+	if err != nil {
+		return LookupAssetResult{}, fmt.Errorf("looking up asset %q of type %q: %w", args.Name, args.Type, err)
+	}
+
+	asset := parseToCatalogAsset(result.Payload)
+	return LookupAssetResult{Asset: &asset}, nil
+}
+
+type SearchAssets struct{}
+
+type SearchAssetsArgs struct {
+	Query           *string  `pulumi:"query,optional"`
+	Types           []string `pulumi:"types,optional"`
+	Services        []string `pulumi:"services,optional"`
+	Tags            []string `pulumi:"tags,optional"`
+	Limit           *int     `pulumi:"limit,optional"`
+	Offset          *int     `pulumi:"offset,optional"`
+	CalculateCounts *bool    `pulumi:"calculateCounts,optional"`
+	MaxResults      *int     `pulumi:"maxResults,optional"`
+}
+
+// AssetFilters holds the number of matching assets for each type, service and
+// tag, as reported by the catalog.
+type AssetFilters struct {
+	Types    map[string]int `pulumi:"types"`
+	Services map[string]int `pulumi:"services"`
+	Tags     map[string]int `pulumi:"tags"`
+}
+
+type SearchAssetsResult struct {
+	Assets  []CatalogAsset `pulumi:"assets"`
+	Total   int            `pulumi:"total"`
+	Filters AssetFilters   `pulumi:"filters"`
+}
+
+const (
+	defaultSearchPageSize   = 100
+	defaultSearchMaxResults = 1000
+)
+
+func (SearchAssets) Call(ctx context.Context, args SearchAssetsArgs) (SearchAssetsResult, error) {
+	pageSize := intValue(args.Limit, defaultSearchPageSize)
+	maxResults := intValue(args.MaxResults, defaultSearchMaxResults)
+	if pageSize <= 0 || maxResults <= 0 {
+		return SearchAssetsResult{}, fmt.Errorf("limit and maxResults must be positive")
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return SearchAssetsResult{}, err
+	}
+
+	result := SearchAssetsResult{Assets: []CatalogAsset{}, Filters: parseToAssetFilters(nil)}
+	offset := int64(intValue(args.Offset, 0))
+	for len(result.Assets) < maxResults {
+		limit := int64(min(pageSize, maxResults-len(result.Assets)))
+		params := assets.NewGetAssetsSearchParamsWithContext(ctx).
+			WithQ(args.Query).
+			WithTypes(args.Types).
+			WithServices(args.Services).
+			WithTags(args.Tags).
+			WithCalculateCounts(args.CalculateCounts).
+			WithLimit(&limit).
+			WithOffset(&offset)
+		page, err := client.Assets.GetAssetsSearch(params)
+		if err != nil {
+			return SearchAssetsResult{}, fmt.Errorf("searching assets: %w", err)
+		}
+
+		result.Total = int(page.Payload.Total)
+		result.Filters = parseToAssetFilters(page.Payload.Filters)
+		for _, asset := range page.Payload.Assets {
+			result.Assets = append(result.Assets, parseToCatalogAsset(asset))
+		}
+
+		offset += int64(len(page.Payload.Assets))
+		if len(page.Payload.Assets) == 0 || offset >= page.Payload.Total {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func parseToAssetFilters(filters *models.AssetAvailableFilters) AssetFilters {
+	result := AssetFilters{
+		Types:    map[string]int{},
+		Services: map[string]int{},
+		Tags:     map[string]int{},
+	}
+	if filters == nil {
+		return result
+	}
+	for k, v := range filters.Types {
+		result.Types[k] = int(v)
+	}
+	for k, v := range filters.Providers {
+		result.Services[k] = int(v)
+	}
+	for k, v := range filters.Tags {
+		result.Tags[k] = int(v)
+	}
+	return result
+}
+
+type ListAssets struct{}
+
+type ListAssetsArgs struct {
+	PageSize    *int `pulumi:"pageSize,optional"`
+	Parallelism *int `pulumi:"parallelism,optional"`
+}
+
+// AssetSummary is the subset of an asset returned when listing the whole
+// catalog, kept small so that large catalogs fit in the provider's memory.
+type AssetSummary struct {
+	ResourceID string   `pulumi:"resourceId"`
+	MRN        string   `pulumi:"mrn"`
+	Name       string   `pulumi:"name"`
+	Type       string   `pulumi:"type"`
+	Providers  []string `pulumi:"services"`
+	Tags       []string `pulumi:"tags,optional"`
+	ParentMRN  string   `pulumi:"parentMrn,optional"`
+	UpdatedAt  string   `pulumi:"updatedAt"`
+}
+
+type ListAssetsResult struct {
+	Assets []AssetSummary `pulumi:"assets"`
+	Count  int            `pulumi:"count"`
+	Total  int            `pulumi:"total"`
+}
+
+const (
+	defaultListPageSize    = 100
+	defaultListParallelism = 4
+)
+
+func (ListAssets) Call(ctx context.Context, args ListAssetsArgs) (ListAssetsResult, error) {
+	pageSize := intValue(args.PageSize, defaultListPageSize)
+	parallelism := intValue(args.Parallelism, defaultListParallelism)
+	if pageSize <= 0 || parallelism <= 0 {
+		return ListAssetsResult{}, fmt.Errorf("pageSize and parallelism must be positive")
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return ListAssetsResult{}, err
+	}
+
+	summaries, total, err := listAssetPages(ctx, client, pageSize, parallelism,
+		func(asset *models.AssetAsset) (AssetSummary, bool) {
+			return AssetSummary{
+				ResourceID: asset.ID,
+				MRN:        asset.Mrn,
+				Name:       asset.Name,
+				Type:       asset.Type,
+				Providers:  asset.Providers,
+				Tags:       asset.Tags,
+				ParentMRN:  asset.ParentMrn,
+				UpdatedAt:  asset.UpdatedAt,
+			}, true
+		})
+	if err != nil {
+		return ListAssetsResult{}, err
+	}
+
+	// Pages are fetched concurrently and the catalog may shift while they are,
+	// so drop duplicates and order by MRN to keep the result stable.
+	seen := make(map[string]bool, len(summaries))
+	result := ListAssetsResult{Assets: make([]AssetSummary, 0, len(summaries)), Total: int(total)}
+	for _, summary := range summaries {
+		if !seen[summary.ResourceID] {
+			seen[summary.ResourceID] = true
+			result.Assets = append(result.Assets, summary)
+		}
+	}
+	sort.Slice(result.Assets, func(i, j int) bool {
+		if result.Assets[i].MRN != result.Assets[j].MRN {
+			return result.Assets[i].MRN < result.Assets[j].MRN
+		}
+		return result.Assets[i].ResourceID < result.Assets[j].ResourceID
+	})
+	result.Count = len(result.Assets)
+	return result, nil
+}
+
+// listAssetPages reads the whole catalog, fetching up to parallelism pages at
+// once. Each asset is passed to convert as soon as its page arrives and only
+// the values it keeps are retained. Results are returned in catalog order along
+// with the catalog total reported by the server.
+func listAssetPages[T any](
+	ctx context.Context, client *client.Marmot, pageSize, parallelism int,
+	convert func(*models.AssetAsset) (T, bool),
+) ([]T, int64, error) {
+	fetch := func(ctx context.Context, page int) ([]T, int64, error) {
+		limit, offset := int64(pageSize), int64(page*pageSize)
+		params := assets.NewGetAssetsListParamsWithContext(ctx).WithLimit(&limit).WithOffset(&offset)
+		result, err := client.Assets.GetAssetsList(params)
+		if err != nil {
+			return nil, 0, fmt.Errorf("listing assets at offset %d: %w", offset, err)
+		}
+		values := make([]T, 0, len(result.Payload.Assets))
+		for _, asset := range result.Payload.Assets {
+			if v, ok := convert(asset); ok {
+				values = append(values, v)
+			}
+		}
+		return values, result.Payload.Total, nil
+	}
+
+	first, total, err := fetch(ctx, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pages := make([][]T, max(1, int((total+int64(pageSize)-1)/int64(pageSize))))
+	pages[0] = first
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallelism)
+	for i := 1; i < len(pages); i++ {
+		g.Go(func() error {
+			values, _, err := fetch(gctx, i)
+			pages[i] = values
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, 0, err
+	}
+
+	var result []T
+	for _, page := range pages {
+		result = append(result, page...)
+	}
+	if result == nil {
+		result = []T{}
+	}
+	return result, total, nil
+}
+
+type MatchAssets struct{}
+
+type MatchAssetsArgs struct {
+	Type    string `pulumi:"type"`
+	Pattern string `pulumi:"pattern"`
+}
+
+type AssetMatch struct {
+	ResourceID string `pulumi:"resourceId"`
+	MRN        string `pulumi:"mrn"`
+	Name       string `pulumi:"name"`
+}
+
+type MatchAssetsResult struct {
+	Matches []AssetMatch `pulumi:"matches"`
+	MRNs    []string     `pulumi:"mrns"`
+}
+
+func (MatchAssets) Call(ctx context.Context, args MatchAssetsArgs) (MatchAssetsResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return MatchAssetsResult{}, err
+	}
+
+	params := assets.NewGetAssetsMatchPatternParamsWithContext(ctx).WithType(args.Type).WithPattern(args.Pattern)
+	result, err := client.Assets.GetAssetsMatchPattern(params)
+	if err != nil {
+		return MatchAssetsResult{}, fmt.Errorf("matching %s assets against %q: %w", args.Type, args.Pattern, err)
+	}
+
+	matches := make([]AssetMatch, 0, len(result.Payload))
+	for _, asset := range result.Payload {
+		matches = append(matches, AssetMatch{
+			ResourceID: asset.ID,
+			MRN:        asset.Mrn,
+			Name:       asset.Name,
+		})
+	}
+	// Sort so that new shards do not reorder existing ones between runs.
+	sort.Slice(matches, func(i, j int) bool { return matches[i].MRN < matches[j].MRN })
+
+	mrns := make([]string, len(matches))
+	for i, match := range matches {
+		mrns[i] = match.MRN
+	}
+	return MatchAssetsResult{Matches: matches, MRNs: mrns}, nil
+}
+
+type GetAssetSummary struct{}
+
+type GetAssetSummaryArgs struct {
+	MinTypeCounts    map[string]int `pulumi:"minTypeCounts,optional"`
+	MinServiceCounts map[string]int `pulumi:"minServiceCounts,optional"`
+	MinTagCounts     map[string]int `pulumi:"minTagCounts,optional"`
+}
+
+type GetAssetSummaryResult struct {
+	Types    map[string]int `pulumi:"types"`
+	Services map[string]int `pulumi:"services"`
+	Tags     map[string]int `pulumi:"tags"`
+	Total    int            `pulumi:"total"`
+}
+
+func (GetAssetSummary) Call(ctx context.Context, args GetAssetSummaryArgs) (GetAssetSummaryResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetAssetSummaryResult{}, err
+	}
+
+	summary, err := client.Assets.GetAssetsSummary(assets.NewGetAssetsSummaryParamsWithContext(ctx))
+	if err != nil {
+		return GetAssetSummaryResult{}, fmt.Errorf("reading asset summary: %w", err)
+	}
+
+	result := GetAssetSummaryResult{
+		Types:    intCounts(summary.Payload.Types),
+		Services: intCounts(summary.Payload.Services),
+		Tags:     intCounts(summary.Payload.Tags),
+	}
+	for _, count := range result.Types {
+		result.Total += count
+	}
+
+	var violations []string
+	violations = append(violations, belowThreshold("type", result.Types, args.MinTypeCounts)...)
+	violations = append(violations, belowThreshold("service", result.Services, args.MinServiceCounts)...)
+	violations = append(violations, belowThreshold("tag", result.Tags, args.MinTagCounts)...)
+	if len(violations) > 0 {
+		return GetAssetSummaryResult{}, fmt.Errorf("catalog coverage below threshold:\n  %s", strings.Join(violations, "\n  "))
+	}
+	return result, nil
+}
+
+func intCounts(counts map[string]int64) map[string]int {
+	result := make(map[string]int, len(counts))
+	for k, v := range counts {
+		result[k] = int(v)
+	}
+	return result
+}
+
+// belowThreshold describes every key whose count is lower than its minimum.
+func belowThreshold(kind string, counts, minimums map[string]int) []string {
+	keys := make([]string, 0, len(minimums))
+	for k := range minimums {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var violations []string
+	for _, k := range keys {
+		if counts[k] < minimums[k] {
+			violations = append(violations, fmt.Sprintf("%s %q has %d assets, expected at least %d", kind, k, counts[k], minimums[k]))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/auth"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/users"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetAuthConfig struct{}
+
+type GetAuthConfigArgs struct{}
+
+type GetAuthConfigResult struct {
+	EnabledProviders []string `pulumi:"enabledProviders"`
+}
+
+func (GetAuthConfig) Call(ctx context.Context, args GetAuthConfigArgs) (GetAuthConfigResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetAuthConfigResult{}, err
+	}
+
+	result, err := client.Auth.GetAuthConfig(auth.NewGetAuthConfigParamsWithContext(ctx))
+	if err != nil {
+		return GetAuthConfigResult{}, fmt.Errorf("reading auth config: %w", err)
+	}
+
+	providers := result.Payload.EnabledProviders
+	if providers == nil {
+		providers = []string{}
+	}
+	return GetAuthConfigResult{EnabledProviders: providers}, nil
+}
+
+// Configure validates the settings and verifies that the Marmot API is
+// reachable and accepts the API key when failFast is enabled, so that a
+// misconfigured provider fails once instead of on every resource.
+func (c Config) Configure(ctx context.Context) error {
+	switch c.StrictTags {
+	case "", strictTagsWarn, strictTagsError:
+	default:
+		return fmt.Errorf("strictTags must be %q or %q, got %q", strictTagsWarn, strictTagsError, c.StrictTags)
+	}
+
+	if !c.FailFast {
+		return nil
+	}
+
+	client, err := c.GetClient()
+	if err != nil {
+		return err
+	}
+
+	// The auth config endpoint does not require credentials, which separates
+	// connectivity problems from authentication ones.
+	if _, err := client.Auth.GetAuthConfig(auth.NewGetAuthConfigParamsWithContext(ctx)); err != nil {
+		return fmt.Errorf("cannot reach Marmot at %s: %w", c.Host, err)
+	}
+
+	if _, err := client.Users.GetUsersMe(users.NewGetUsersMeParamsWithContext(ctx), nil); err != nil {
+		var unauthorized *users.GetUsersMeUnauthorized
+		if errors.As(err, &unauthorized) {
+			return fmt.Errorf("the configured apiKey was rejected by Marmot at %s", c.Host)
+		}
+		return fmt.Errorf("authenticating with Marmot at %s: %w", c.Host, err)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+)
+
+const (
+	defaultBatchWindow = 50 * time.Millisecond
+	defaultBatchSize   = 100
+
+	assetStatusCreated = "created"
+)
+
+// createAsset creates the asset directly, or through the shared batcher when
+// batchCreates is enabled.
+func createAsset(ctx context.Context, config Config, client assets.ClientService, asset *models.AssetsCreateRequest) (*models.AssetAsset, error) {
+	if config.BatchCreates {
+		return assetBatcherFor(config, client).create(ctx, asset)
+	}
+	return postAsset(client, asset)
+}
+
+// postAsset creates a single asset. Unlike the batch endpoint, it rejects
+// assets that already exist.
+func postAsset(client assets.ClientService, asset *models.AssetsCreateRequest) (*models.AssetAsset, error) {
+	result, err := client.PostAssets(assets.NewPostAssetsParams().WithAsset(asset))
+	var conflict *assets.PostAssetsConflict
+	if errors.As(err, &conflict) {
+		return nil, fmt.Errorf("creating asset %q: asset already exists, import it instead", *asset.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.Payload, nil
+}
+
+// assetBatcher coalesces asset creates that arrive within a short window into
+// a single PostAssetsBatch call, routing each per-asset result back to its
+// caller.
+type assetBatcher struct {
+	client assets.ClientService
+	window time.Duration
+	size   int
+
+	mu      sync.Mutex
+	pending []*pendingAsset
+	timer   *time.Timer
+}
+
+type pendingAsset struct {
+	ctx     context.Context
+	request *models.AssetsCreateRequest
+	done    chan pendingAssetResult
+}
+
+type pendingAssetResult struct {
+	asset *models.AssetAsset
+	err   error
+}
+
+type batcherKey struct {
+	host, apiKey string
+	window       time.Duration
+	size         int
+}
+
+// assetBatchers holds one batcher per provider configuration, since the
+// configuration is copied into each call and cannot carry shared state.
+var assetBatchers sync.Map
+
+func assetBatcherFor(config Config, client assets.ClientService) *assetBatcher {
+	key := batcherKey{
+		host:   config.Host,
+		apiKey: config.APIKey,
+		window: defaultBatchWindow,
+		size:   intValue(config.BatchSize, defaultBatchSize),
+	}
+	if config.BatchWindowMs != nil {
+		key.window = time.Duration(*config.BatchWindowMs) * time.Millisecond
+	}
+
+	batcher, _ := assetBatchers.LoadOrStore(key, &assetBatcher{
+		client: client,
+		window: key.window,
+		size:   max(1, key.size),
+	})
+	return batcher.(*assetBatcher)
+}
+
+func (b *assetBatcher) create(ctx context.Context, request *models.AssetsCreateRequest) (*models.AssetAsset, error) {
+	// The batch endpoint upserts, so it would overwrite an existing asset with
+	// this resource's inputs. Assets that may already exist are created on
+	// their own instead, where the API rejects them.
+	params := assets.NewGetAssetsLookupTypeNameParamsWithContext(ctx).WithType(*request.Type).WithName(*request.Name)
+	_, err := b.client.GetAssetsLookupTypeName(params)
+	var notFound *assets.GetAssetsLookupTypeNameNotFound
+	switch {
+	case err == nil:
+		return postAsset(b.client, request)
+	case !errors.As(err, &notFound):
+		return nil, fmt.Errorf("looking up asset %q: %w", *request.Name, err)
+	}
+
+	item := &pendingAsset{ctx: ctx, request: request, done: make(chan pendingAssetResult, 1)}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, item)
+	switch {
+	case len(b.pending) >= b.size:
+		go b.flush(b.take())
+	case len(b.pending) == 1:
+		b.timer = time.AfterFunc(b.window, func() {
+			b.mu.Lock()
+			batch := b.take()
+			b.mu.Unlock()
+			b.flush(batch)
+		})
+	}
+	b.mu.Unlock()
+
+	select {
+	case result := <-item.done:
+		return result.asset, result.err
+	case <-ctx.Done():
+		if b.cancel(item) {
+			return nil, ctx.Err()
+		}
+		// The batch has been taken and may already be sent, so its result
+		// is returned to be recorded in state rather than leaked.
+		result := <-item.done
+		return result.asset, result.err
+	}
+}
+
+// cancel removes an item that has not been taken for a batch yet, so that the
+// asset is not created without a resource to track it. It reports whether the
+// item was removed.
+func (b *assetBatcher) cancel(item *pendingAsset) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, pending := range b.pending {
+		if pending == item {
+			b.pending = append(b.pending[:i], b.pending[i+1:]...)
+			if len(b.pending) == 0 {
+				b.take()
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// take removes the pending assets. It must be called with b.mu held.
+func (b *assetBatcher) take() []*pendingAsset {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+func (b *assetBatcher) flush(batch []*pendingAsset) {
+	// Drop items whose Create was cancelled while the batch was pending.
+	live := batch[:0]
+	for _, item := range batch {
+		if err := item.ctx.Err(); err != nil {
+			item.done <- pendingAssetResult{err: err}
+			continue
+		}
+		live = append(live, item)
+	}
+	batch = live
+	if len(batch) == 0 {
+		return
+	}
+
+	request := &models.AssetsBatchCreateRequest{Assets: make([]*models.AssetsCreateRequest, len(batch))}
+	for i, item := range batch {
+		request.Assets[i] = item.request
+	}
+
+	// The batch outlives the individual Create calls that contributed to it,
+	// so it is not bound to any of their contexts.
+	result, err := b.client.PostAssetsBatch(assets.NewPostAssetsBatchParams().WithRequest(request))
+	if err == nil && len(result.Payload.Assets) != len(batch) {
+		err = fmt.Errorf("batch create returned %d results for %d assets", len(result.Payload.Assets), len(batch))
+	}
+	if err != nil {
+		for _, item := range batch {
+			item.done <- pendingAssetResult{err: fmt.Errorf("creating assets in batch: %w", err)}
+		}
+		return
+	}
+
+	// Results are returned in the order of the request. An asset created by
+	// someone else since it was looked up is reported rather than adopted.
+	for i, item := range batch {
+		applied := parseBatchAssetResult(item.request, result.Payload.Assets[i])
+		if applied.err == nil && result.Payload.Assets[i].Status != assetStatusCreated {
+			applied = pendingAssetResult{err: fmt.Errorf("creating asset %q: asset %s already exists (status %q), import it instead",
+				*item.request.Name, applied.asset.ID, result.Payload.Assets[i].Status)}
+		}
+		item.done <- applied
+	}
+}
+
+func parseBatchAssetResult(request *models.AssetsCreateRequest, result *models.AssetsBatchAssetResult) pendingAssetResult {
+	switch {
+	case result == nil:
+		return pendingAssetResult{err: fmt.Errorf("creating asset %q: missing batch result", *request.Name)}
+	case result.Error != "":
+		return pendingAssetResult{err: fmt.Errorf("creating asset %q: %s", *request.Name, result.Error)}
+	case result.Asset == nil:
+		return pendingAssetResult{err: fmt.Errorf("creating asset %q: batch result has status %q but no asset", *request.Name, result.Status)}
+	}
+	return pendingAssetResult{asset: result.Asset}
+}
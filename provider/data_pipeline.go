@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// DataPipeline is a component that wires a job between its input and output
+// assets. Assets given as definitions are created in one AssetCollection, and
+// the edges from each input to the job and from the job to each output are
+// created in one LineageSet with the job's MRN set.
+type DataPipeline struct {
+	pulumi.ResourceState
+
+	InputMRNs  pulumi.StringArrayOutput    `pulumi:"inputMrns"`
+	JobMRN     pulumi.StringOutput         `pulumi:"jobMrn"`
+	OutputMRNs pulumi.StringArrayOutput    `pulumi:"outputMrns"`
+	Edges      pulumi.StringMapArrayOutput `pulumi:"edges"`
+}
+
+type DataPipelineArgs struct {
+	Inputs     []AssetCollectionItem   `pulumi:"inputs,optional"`
+	InputMRNs  pulumi.StringArrayInput `pulumi:"inputMrns,optional"`
+	Job        *AssetCollectionItem    `pulumi:"job,optional"`
+	JobMRN     pulumi.StringInput      `pulumi:"jobMrn,optional"`
+	Outputs    []AssetCollectionItem   `pulumi:"outputs,optional"`
+	OutputMRNs pulumi.StringArrayInput `pulumi:"outputMrns,optional"`
+}
+
+// assetCollectionResource and lineageSetResource reference the provider's own
+// resources from within the component.
+type assetCollectionResource struct {
+	pulumi.CustomResourceState
+
+	MRNs pulumi.StringMapOutput `pulumi:"mrns"`
+}
+
+type lineageSetResource struct {
+	pulumi.CustomResourceState
+}
+
+const (
+	pipelineJobKey       = "job"
+	pipelineInputKey     = "input/"
+	pipelineOutputKey    = "output/"
+	dataPipelineToken    = "marmot:index:DataPipeline"
+	assetCollectionToken = "marmot:index:AssetCollection"
+	lineageSetToken      = "marmot:index:LineageSet"
+)
+
+func NewDataPipeline(ctx *pulumi.Context, name string, args DataPipelineArgs, opts ...pulumi.ResourceOption) (*DataPipeline, error) {
+	if (args.Job == nil) == (args.JobMRN == nil) {
+		return nil, fmt.Errorf("exactly one of job or jobMrn must be set")
+	}
+
+	pipeline := &DataPipeline{}
+	if err := ctx.RegisterComponentResource(dataPipelineToken, name, pipeline, opts...); err != nil {
+		return nil, err
+	}
+
+	// Assets are keyed by name within inputs and within outputs, so two
+	// definitions sharing a name are rejected rather than one replacing the
+	// other in the AssetCollection.
+	items := pulumi.Map{}
+	declared := map[string]AssetCollectionItem{}
+	add := func(key string, item AssetCollectionItem) error {
+		if other, exists := declared[key]; exists {
+			return fmt.Errorf("assets %s (%s) and %s (%s) both map to key %q; asset names must be unique among a pipeline's inputs and among its outputs",
+				describeAsset(other), other.Type, describeAsset(item), item.Type, key)
+		}
+		declared[key] = item
+		items[key] = assetItemInput(item)
+		return nil
+	}
+	for _, item := range args.Inputs {
+		if err := add(pipelineInputKey+item.Name, item); err != nil {
+			return nil, err
+		}
+	}
+	if args.Job != nil {
+		if err := add(pipelineJobKey, *args.Job); err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range args.Outputs {
+		if err := add(pipelineOutputKey+item.Name, item); err != nil {
+			return nil, err
+		}
+	}
+
+	mrns := pulumi.StringMap{}.ToStringMapOutput()
+	if len(items) > 0 {
+		var collection assetCollectionResource
+		err := ctx.RegisterResource(assetCollectionToken, name+"-assets", pulumi.Map{"assets": items}, &collection, pulumi.Parent(pipeline))
+		if err != nil {
+			return nil, err
+		}
+		mrns = collection.MRNs
+	}
+
+	pipeline.InputMRNs = pipelineMRNs(args.InputMRNs, mrns, pipelineInputKey, args.Inputs)
+	pipeline.OutputMRNs = pipelineMRNs(args.OutputMRNs, mrns, pipelineOutputKey, args.Outputs)
+	if args.JobMRN != nil {
+		pipeline.JobMRN = args.JobMRN.ToStringOutput()
+	} else {
+		pipeline.JobMRN = mrns.MapIndex(pulumi.String(pipelineJobKey))
+	}
+
+	pipeline.Edges = pulumi.All(pipeline.InputMRNs, pipeline.JobMRN, pipeline.OutputMRNs).ApplyT(func(values []interface{}) []map[string]string {
+		inputs, job, outputs := values[0].([]string), values[1].(string), values[2].([]string)
+		edges := make([]map[string]string, 0, len(inputs)+len(outputs))
+		for _, input := range inputs {
+			edges = append(edges, map[string]string{"source": input, "target": job, "jobMrn": job})
+		}
+		for _, output := range outputs {
+			edges = append(edges, map[string]string{"source": job, "target": output, "jobMrn": job})
+		}
+		return edges
+	}).(pulumi.StringMapArrayOutput)
+
+	var lineageSet lineageSetResource
+	err := ctx.RegisterResource(lineageSetToken, name+"-lineage", pulumi.Map{"edges": pipeline.Edges}, &lineageSet, pulumi.Parent(pipeline))
+	if err != nil {
+		return nil, err
+	}
+
+	return pipeline, nil
+}
+
+// pipelineMRNs lists the existing MRNs followed by the MRNs of the assets
+// created for the given items.
+func pipelineMRNs(existing pulumi.StringArrayInput, mrns pulumi.StringMapOutput, prefix string, items []AssetCollectionItem) pulumi.StringArrayOutput {
+	if existing == nil {
+		existing = pulumi.StringArray{}
+	}
+	return pulumi.All(existing, mrns).ApplyT(func(values []interface{}) []string {
+		created := values[1].(map[string]string)
+		result := append([]string{}, values[0].([]string)...)
+		for _, item := range items {
+			result = append(result, created[prefix+item.Name])
+		}
+		return result
+	}).(pulumi.StringArrayOutput)
+}
+
+// describeAsset names an asset definition by its name and services.
+func describeAsset(item AssetCollectionItem) string {
+	return fmt.Sprintf("%q on %s", item.Name, strings.Join(item.Providers, ","))
+}
+
+// assetItemInput converts an asset definition into the inputs of an
+// AssetCollection item.
+func assetItemInput(item AssetCollectionItem) pulumi.Map {
+	props := pulumi.Map{
+		"name":     pulumi.String(item.Name),
+		"type":     pulumi.String(item.Type),
+		"services": pulumi.ToStringArray(item.Providers),
+	}
+	if item.Description != "" {
+		props["description"] = pulumi.String(item.Description)
+	}
+	if len(item.Tags) > 0 {
+		props["tags"] = pulumi.ToStringArray(item.Tags)
+	}
+	if item.Metadata != nil {
+		props["metadata"] = pulumi.ToMap(item.Metadata)
+	}
+	if item.Schema != nil {
+		props["schema"] = pulumi.ToMap(item.Schema)
+	}
+	if len(item.IgnoreMetadataKeys) > 0 {
+		props["ignoreMetadataKeys"] = pulumi.ToStringArray(item.IgnoreMetadataKeys)
+	}
+
+	if len(item.ExternalLinks) > 0 {
+		links := pulumi.Array{}
+		for _, link := range item.ExternalLinks {
+			l := pulumi.Map{"name": pulumi.String(link.Name), "url": pulumi.String(link.URL)}
+			if link.Icon != nil {
+				l["icon"] = pulumi.String(*link.Icon)
+			}
+			links = append(links, l)
+		}
+		props["externalLinks"] = links
+	}
+
+	if len(item.Sources) > 0 {
+		sources := pulumi.Array{}
+		for _, source := range item.Sources {
+			s := pulumi.Map{"name": pulumi.String(source.Name)}
+			if source.Priority != nil {
+				s["priority"] = pulumi.Int(int(*source.Priority))
+			}
+			if source.Properties != nil {
+				s["properties"] = pulumi.ToMap(source.Properties)
+			}
+			sources = append(sources, s)
+		}
+		props["sources"] = sources
+	}
+
+	if len(item.Environments) > 0 {
+		environments := pulumi.Map{}
+		for key, env := range item.Environments {
+			e := pulumi.Map{"name": pulumi.String(env.Name), "path": pulumi.String(env.Path)}
+			if env.Metadata != nil {
+				e["metadata"] = pulumi.ToMap(env.Metadata)
+			}
+			environments[key] = e
+		}
+		props["environments"] = environments
+	}
+
+	return props
+}
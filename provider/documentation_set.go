@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// DocumentationSet syncs a directory of markdown files to asset documentation.
+// Each file is mapped to an asset MRN from its path, and only files whose
+// content changed are uploaded. Documentation of removed files is left in the
+// catalog, as Marmot has no API to delete it.
+type DocumentationSet struct{}
+
+type DocumentationSetArgs struct {
+	Directory   string  `pulumi:"directory"`
+	MRNTemplate *string `pulumi:"mrnTemplate,optional"`
+	Source      *string `pulumi:"source,optional"`
+	// GlobalDocs maps a file path to the MRNs of other assets that share the
+	// document.
+	GlobalDocs map[string][]string `pulumi:"globalDocs,optional"`
+}
+
+// DocumentResult is the sync status of a single markdown file.
+type DocumentResult struct {
+	MRN    string `pulumi:"mrn"`
+	Hash   string `pulumi:"hash"`
+	Status string `pulumi:"status"`
+	Error  string `pulumi:"error,optional"`
+}
+
+type DocumentationSetState struct {
+	DocumentationSetArgs
+	Documents map[string]DocumentResult `pulumi:"documents"`
+}
+
+const (
+	defaultDocumentMRNTemplate = "mrn://{path}"
+	defaultDocumentSource      = "pulumi"
+)
+
+// localDocument is a markdown file read from the directory.
+type localDocument struct {
+	mrn     string
+	hash    string
+	content string
+}
+
+func (DocumentationSet) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (DocumentationSetArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[DocumentationSetArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	if args.MRNTemplate != nil && !strings.Contains(*args.MRNTemplate, "{path}") {
+		failures = append(failures, p.CheckFailure{
+			Property: "mrnTemplate",
+			Reason:   "mrnTemplate must contain {path}, otherwise every file maps to the same asset",
+		})
+	}
+	return args, failures, nil
+}
+
+// readDocuments hashes every markdown file under the directory, keyed by its
+// slash-separated path relative to the directory.
+func readDocuments(args DocumentationSetArgs) (map[string]localDocument, error) {
+	template := defaultDocumentMRNTemplate
+	if args.MRNTemplate != nil {
+		template = *args.MRNTemplate
+	}
+
+	documents := map[string]localDocument{}
+	err := filepath.WalkDir(args.Directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || filepath.Ext(path) != ".md" {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(args.Directory, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		sum := sha256.Sum256(content)
+		documents[rel] = localDocument{
+			mrn:     strings.ReplaceAll(template, "{path}", strings.TrimSuffix(rel, ".md")),
+			hash:    hex.EncodeToString(sum[:]),
+			content: string(content),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading documentation directory %q: %w", args.Directory, err)
+	}
+	return documents, nil
+}
+
+// documentChanged reports whether a file must be uploaded again.
+func documentChanged(old DocumentResult, exists bool, document localDocument) bool {
+	return !exists || old.Error != "" || old.Hash != document.hash || old.MRN != document.mrn
+}
+
+// settingsChanged reports whether a change to the arguments affects every
+// uploaded document.
+func (args DocumentationSetArgs) settingsChanged(olds DocumentationSetArgs) bool {
+	return stringValue(args.Source) != stringValue(olds.Source) ||
+		!reflect.DeepEqual(args.GlobalDocs, olds.GlobalDocs)
+}
+
+// Diff hashes the files on disk, so that edited documents are detected even
+// when the resource inputs are unchanged.
+func (DocumentationSet) Diff(ctx context.Context, id string, olds DocumentationSetState, news DocumentationSetArgs) (p.DiffResponse, error) {
+	detailedDiff := map[string]p.PropertyDiff{}
+	if olds.Directory != news.Directory {
+		detailedDiff["directory"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if stringValue(olds.MRNTemplate) != stringValue(news.MRNTemplate) {
+		detailedDiff["mrnTemplate"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if stringValue(olds.Source) != stringValue(news.Source) {
+		detailedDiff["source"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if !reflect.DeepEqual(olds.GlobalDocs, news.GlobalDocs) {
+		detailedDiff["globalDocs"] = p.PropertyDiff{Kind: p.Update}
+	}
+
+	documents, err := readDocuments(news)
+	if err != nil {
+		return p.DiffResponse{}, err
+	}
+	for path, document := range documents {
+		old, exists := olds.Documents[path]
+		if !documentChanged(old, exists, document) {
+			continue
+		}
+		kind := p.Update
+		if !exists {
+			kind = p.Add
+		}
+		detailedDiff[fmt.Sprintf("documents[%q]", path)] = p.PropertyDiff{Kind: kind}
+	}
+	for path := range olds.Documents {
+		if _, exists := documents[path]; !exists {
+			detailedDiff[fmt.Sprintf("documents[%q]", path)] = p.PropertyDiff{Kind: p.Delete}
+		}
+	}
+
+	return p.DiffResponse{
+		HasChanges:   len(detailedDiff) > 0,
+		DetailedDiff: detailedDiff,
+	}, nil
+}
+
+func (DocumentationSet) Create(ctx context.Context, name string, input DocumentationSetArgs, preview bool) (string, DocumentationSetState, error) {
+	state, err := syncDocumentation(ctx, DocumentationSetState{}, input, preview)
+	return name, state, err
+}
+
+func (DocumentationSet) Update(ctx context.Context, id string, olds DocumentationSetState, news DocumentationSetArgs, preview bool) (DocumentationSetState, error) {
+	return syncDocumentation(ctx, olds, news, preview)
+}
+
+// syncDocumentation uploads the documents that changed since olds in batches
+// and records the status reported for each of them. Failed documents are kept
+// with their error, so that they are uploaded again on the next update.
+func syncDocumentation(ctx context.Context, olds DocumentationSetState, news DocumentationSetArgs, preview bool) (DocumentationSetState, error) {
+	state := DocumentationSetState{DocumentationSetArgs: news, Documents: map[string]DocumentResult{}}
+
+	documents, err := readDocuments(news)
+	if err != nil {
+		return state, err
+	}
+
+	settingsChanged := news.settingsChanged(olds.DocumentationSetArgs)
+	var changed []string
+	for _, path := range sortedKeys(documents) {
+		old, exists := olds.Documents[path]
+		if !settingsChanged && !documentChanged(old, exists, documents[path]) {
+			state.Documents[path] = old
+			continue
+		}
+		changed = append(changed, path)
+	}
+
+	if preview {
+		for _, path := range changed {
+			state.Documents[path] = DocumentResult{MRN: documents[path].mrn, Hash: documents[path].hash}
+		}
+		return state, nil
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return state, err
+	}
+
+	source := defaultDocumentSource
+	if news.Source != nil {
+		source = *news.Source
+	}
+
+	var reasons []string
+	for start := 0; start < len(changed); start += defaultBatchSize {
+		chunk := changed[start:min(start+defaultBatchSize, len(changed))]
+		request := &models.AssetsBatchDocumentationRequest{Documentation: make([]*models.AssetdocsDocumentation, len(chunk))}
+		for i, path := range chunk {
+			request.Documentation[i] = &models.AssetdocsDocumentation{
+				Mrn:        documents[path].mrn,
+				Content:    documents[path].content,
+				Source:     source,
+				GlobalDocs: news.GlobalDocs[path],
+			}
+		}
+
+		result, err := client.Assets.PostAssetsDocumentationBatch(assets.NewPostAssetsDocumentationBatchParamsWithContext(ctx).WithRequest(request))
+		if err == nil && len(result.Payload.Results) != len(chunk) {
+			err = fmt.Errorf("documentation batch returned %d results for %d documents", len(result.Payload.Results), len(chunk))
+		}
+
+		// Results are returned in the order of the request.
+		for i, path := range chunk {
+			document := DocumentResult{MRN: documents[path].mrn, Hash: documents[path].hash}
+			switch {
+			case err != nil:
+				document.Status, document.Error = "failed", err.Error()
+			case result.Payload.Results[i] == nil:
+				document.Status, document.Error = "failed", "missing batch result"
+			default:
+				document.Status = result.Payload.Results[i].Status
+				document.Error = result.Payload.Results[i].Error
+			}
+			if document.Error != "" {
+				reasons = append(reasons, fmt.Sprintf("uploading %s for %s: %s", path, document.MRN, document.Error))
+			}
+			state.Documents[path] = document
+		}
+	}
+
+	if len(reasons) > 0 {
+		return state, infer.ResourceInitFailedError{Reasons: reasons}
+	}
+	return state, nil
+}
+
+// Delete only forgets the documents, as Marmot has no API to delete
+// documentation.
+func (DocumentationSet) Delete(ctx context.Context, id string, state DocumentationSetState) error {
+	return nil
+}
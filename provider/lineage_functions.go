@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/lineage"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetLineage struct{}
+
+type GetLineageArgs struct {
+	ResourceID string  `pulumi:"resourceId"`
+	Direction  *string `pulumi:"direction,optional"`
+	Depth      *int    `pulumi:"depth,optional"`
+}
+
+type LineageNode struct {
+	ID    string        `pulumi:"resourceId"`
+	Asset *CatalogAsset `pulumi:"asset,optional"`
+	Depth int           `pulumi:"depth"`
+	Type  string        `pulumi:"type"`
+}
+
+type LineageEdge struct {
+	ResourceID string `pulumi:"resourceId"`
+	Source     string `pulumi:"source"`
+	Target     string `pulumi:"target"`
+	JobMRN     string `pulumi:"jobMrn,optional"`
+	Type       string `pulumi:"type,optional"`
+}
+
+type GetLineageResult struct {
+	Nodes []LineageNode `pulumi:"nodes"`
+	Edges []LineageEdge `pulumi:"edges"`
+}
+
+func (GetLineage) Call(ctx context.Context, args GetLineageArgs) (GetLineageResult, error) {
+	direction := "both"
+	if args.Direction != nil {
+		direction = *args.Direction
+	}
+	if direction != "upstream" && direction != "downstream" && direction != "both" {
+		return GetLineageResult{}, fmt.Errorf("direction must be one of upstream, downstream or both, got %q", direction)
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetLineageResult{}, err
+	}
+
+	graph, err := readLineage(ctx, client.Lineage, args.ResourceID, direction, args.Depth)
+	if err != nil {
+		return GetLineageResult{}, err
+	}
+
+	result := GetLineageResult{
+		Nodes: make([]LineageNode, 0, len(graph.Nodes)),
+		Edges: make([]LineageEdge, 0, len(graph.Edges)),
+	}
+	for _, node := range graph.Nodes {
+		n := LineageNode{
+			ID:    node.ID,
+			Depth: int(node.Depth),
+			Type:  node.Type,
+		}
+		if node.Asset != nil {
+			asset := parseToCatalogAsset(node.Asset)
+			n.Asset = &asset
+		}
+		result.Nodes = append(result.Nodes, n)
+	}
+	for _, edge := range graph.Edges {
+		result.Edges = append(result.Edges, parseToLineageEdge(edge))
+	}
+	return result, nil
+}
+
+func readLineage(ctx context.Context, client lineage.ClientService, id, direction string, depth *int) (*models.LineageLineageResponse, error) {
+	params := lineage.NewGetLineageAssetsIDParamsWithContext(ctx).
+		WithID(strfmt.UUID(id)).
+		WithDirection(&direction)
+	if depth != nil {
+		limit := int64(*depth)
+		params = params.WithLimit(&limit)
+	}
+
+	result, err := client.GetLineageAssetsID(params)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s lineage of asset %q: %w", direction, id, err)
+	}
+	return result.Payload, nil
+}
+
+func parseToLineageEdge(edge *models.LineageLineageEdge) LineageEdge {
+	return LineageEdge{
+		ResourceID: edge.ID,
+		Source:     edge.Source,
+		Target:     edge.Target,
+		JobMRN:     edge.JobMrn,
+		Type:       edge.Type,
+	}
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/lineage"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// LineageSet manages a group of lineage edges as one resource, creating them
+// with a single batch call. Edges are identified by their content, so adding
+// an edge to the set only creates that edge.
+type LineageSet struct{}
+
+type LineageSetEdge struct {
+	Source string `pulumi:"source"`
+	Target string `pulumi:"target"`
+	JobMRN string `pulumi:"jobMrn,optional"`
+}
+
+type LineageSetArgs struct {
+	Edges []LineageSetEdge `pulumi:"edges"`
+}
+
+// LineageSetEdgeResult is the outcome of creating an edge in a batch. Status is
+// "created" for new edges and "existing" for edges that were already present.
+type LineageSetEdgeResult struct {
+	LineageSetEdge
+	ResourceID string `pulumi:"resourceId"`
+	Type       string `pulumi:"type,optional"`
+	Status     string `pulumi:"status"`
+}
+
+type LineageSetState struct {
+	LineageSetArgs
+	Results []LineageSetEdgeResult `pulumi:"results"`
+}
+
+const lineageStatusCreated = "created"
+
+func (e LineageSetEdge) String() string {
+	if e.JobMRN == "" {
+		return fmt.Sprintf("%s -> %s", e.Source, e.Target)
+	}
+	return fmt.Sprintf("%s -> %s (%s)", e.Source, e.Target, e.JobMRN)
+}
+
+func (LineageSet) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (LineageSetArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[LineageSetArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	seen := map[LineageSetEdge]bool{}
+	for i, edge := range args.Edges {
+		if seen[edge] {
+			failures = append(failures, p.CheckFailure{
+				Property: fmt.Sprintf("edges[%d]", i),
+				Reason:   fmt.Sprintf("edge %s is declared more than once", edge),
+			})
+		}
+		seen[edge] = true
+	}
+	return args, failures, nil
+}
+
+func (LineageSet) Diff(ctx context.Context, id string, olds LineageSetState, news LineageSetArgs) (p.DiffResponse, error) {
+	detailedDiff := map[string]p.PropertyDiff{}
+
+	newEdges := map[LineageSetEdge]bool{}
+	for _, edge := range news.Edges {
+		newEdges[edge] = true
+	}
+	oldEdges := map[LineageSetEdge]bool{}
+	for i, edge := range olds.Edges {
+		oldEdges[edge] = true
+		if !newEdges[edge] {
+			detailedDiff[fmt.Sprintf("edges[%d]", i)] = p.PropertyDiff{Kind: p.Delete}
+		}
+	}
+	for i, edge := range news.Edges {
+		if oldEdges[edge] {
+			continue
+		}
+		// An edge removed at the same position is reported as replaced.
+		path := fmt.Sprintf("edges[%d]", i)
+		if _, removed := detailedDiff[path]; removed {
+			detailedDiff[path] = p.PropertyDiff{Kind: p.Update}
+		} else {
+			detailedDiff[path] = p.PropertyDiff{Kind: p.Add}
+		}
+	}
+
+	return p.DiffResponse{
+		HasChanges:   len(detailedDiff) > 0,
+		DetailedDiff: detailedDiff,
+	}, nil
+}
+
+func (LineageSet) Create(ctx context.Context, name string, input LineageSetArgs, preview bool) (string, LineageSetState, error) {
+	state := LineageSetState{LineageSetArgs: input, Results: []LineageSetEdgeResult{}}
+	if preview {
+		return name, state, nil
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return "", state, err
+	}
+
+	results, err := createLineageEdges(ctx, client.Lineage, input.Edges)
+	state.Results = results
+	if err != nil {
+		// Keep the edges that were created, so that they are tracked and the
+		// rest are retried on the next update.
+		state.Edges = resultEdges(results)
+		return name, state, infer.ResourceInitFailedError{Reasons: []string{err.Error()}}
+	}
+	return name, state, nil
+}
+
+func (LineageSet) Update(ctx context.Context, id string, olds LineageSetState, news LineageSetArgs, preview bool) (LineageSetState, error) {
+	newEdges := map[LineageSetEdge]bool{}
+	for _, edge := range news.Edges {
+		newEdges[edge] = true
+	}
+
+	state := LineageSetState{LineageSetArgs: news, Results: []LineageSetEdgeResult{}}
+	var removed []LineageSetEdgeResult
+	applied := map[LineageSetEdge]bool{}
+	for _, result := range olds.Results {
+		if newEdges[result.LineageSetEdge] {
+			state.Results = append(state.Results, result)
+			applied[result.LineageSetEdge] = true
+		} else {
+			removed = append(removed, result)
+		}
+	}
+
+	var added []LineageSetEdge
+	for _, edge := range news.Edges {
+		if !applied[edge] {
+			added = append(added, edge)
+		}
+	}
+
+	if preview {
+		return state, nil
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return olds, err
+	}
+
+	var reasons []string
+	for _, result := range removed {
+		if err := deleteLineageEdge(ctx, client.Lineage, result); err != nil {
+			reasons = append(reasons, fmt.Sprintf("deleting edge %s: %s", result.LineageSetEdge, err))
+			state.Results = append(state.Results, result)
+		}
+	}
+
+	results, err := createLineageEdges(ctx, client.Lineage, added)
+	state.Results = append(state.Results, results...)
+	if err != nil {
+		reasons = append(reasons, err.Error())
+	}
+
+	if len(reasons) > 0 {
+		state.Edges = resultEdges(state.Results)
+		return state, infer.ResourceInitFailedError{Reasons: reasons}
+	}
+	return state, nil
+}
+
+// Delete removes the edges created by this set. Edges that already existed
+// when the set was applied are left in place.
+func (LineageSet) Delete(ctx context.Context, id string, state LineageSetState) error {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, result := range state.Results {
+		if err := deleteLineageEdge(ctx, client.Lineage, result); err != nil {
+			errs = append(errs, fmt.Errorf("deleting edge %s: %w", result.LineageSetEdge, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// createLineageEdges creates edges with PostLineageBatch and returns a result
+// for each edge the batch reported on.
+func createLineageEdges(ctx context.Context, client lineage.ClientService, edges []LineageSetEdge) ([]LineageSetEdgeResult, error) {
+	results := []LineageSetEdgeResult{}
+	if len(edges) == 0 {
+		return results, nil
+	}
+
+	request := make([]*models.LineageLineageEdge, len(edges))
+	for i, edge := range edges {
+		request[i] = &models.LineageLineageEdge{
+			Source: edge.Source,
+			Target: edge.Target,
+			JobMrn: edge.JobMRN,
+		}
+	}
+
+	response, err := client.PostLineageBatch(lineage.NewPostLineageBatchParamsWithContext(ctx).WithEdges(request))
+	if err != nil {
+		return results, fmt.Errorf("creating lineage edges: %w", err)
+	}
+
+	// Results are matched to the declared edges by content rather than
+	// position, since the batch may skip or reorder edges.
+	created := map[LineageSetEdge]*models.LineageBatchLineageResult{}
+	for _, result := range response.Payload {
+		if result == nil || result.Edge == nil {
+			continue
+		}
+		edge := LineageSetEdge{Source: result.Edge.Source, Target: result.Edge.Target, JobMRN: result.Edge.JobMrn}
+		created[edge] = result
+	}
+
+	var missing []string
+	for _, edge := range edges {
+		result, ok := created[edge]
+		if !ok {
+			missing = append(missing, edge.String())
+			continue
+		}
+		results = append(results, LineageSetEdgeResult{
+			LineageSetEdge: edge,
+			ResourceID:     result.Edge.ID,
+			Type:           result.Edge.Type,
+			Status:         result.Status,
+		})
+	}
+	if len(missing) > 0 {
+		return results, fmt.Errorf("lineage batch returned no result for edges: %v", missing)
+	}
+	return results, nil
+}
+
+func deleteLineageEdge(ctx context.Context, client lineage.ClientService, result LineageSetEdgeResult) error {
+	if result.Status != lineageStatusCreated || result.ResourceID == "" {
+		return nil
+	}
+
+	params := lineage.NewDeleteLineageDirectIDParamsWithContext(ctx).WithID(strfmt.UUID(result.ResourceID))
+	_, err := client.DeleteLineageDirectID(params)
+	return err
+}
+
+func resultEdges(results []LineageSetEdgeResult) []LineageSetEdge {
+	edges := make([]LineageSetEdge, len(results))
+	for i, result := range results {
+		edges[i] = result.LineageSetEdge
+	}
+	return edges
+}
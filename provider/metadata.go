@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"path"
+)
+
+// ignoredMetadataPatterns returns the glob patterns of metadata keys that are
+// owned by the Marmot server, combining the provider and asset settings.
+func ignoredMetadataPatterns(config Config, args AssetArgs) []string {
+	patterns := make([]string, 0, len(config.IgnoreMetadataKeys)+len(args.IgnoreMetadataKeys))
+	patterns = append(patterns, config.IgnoreMetadataKeys...)
+	return append(patterns, args.IgnoreMetadataKeys...)
+}
+
+// hiddenMetadataPatterns extends the ignored patterns with the reserved
+// provenance keys, which are written by the provider but never diffed.
+func hiddenMetadataPatterns(config Config, args AssetArgs) []string {
+	patterns := ignoredMetadataPatterns(config, args)
+	if config.Provenance {
+		patterns = append(patterns, provenanceKeys...)
+	}
+	return patterns
+}
+
+func metadataKeyIgnored(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, key); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutIgnoredMetadata returns a copy of metadata without the keys matching
+// any of the patterns.
+func withoutIgnoredMetadata(metadata map[string]interface{}, patterns []string) map[string]interface{} {
+	if metadata == nil || len(patterns) == 0 {
+		return metadata
+	}
+	result := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		if !metadataKeyIgnored(patterns, k) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// preserveIgnoredMetadata overlays the server's current values for ignored keys
+// onto the desired metadata, so that updates do not wipe them.
+func preserveIgnoredMetadata(desired, current map[string]interface{}, patterns []string) map[string]interface{} {
+	if len(patterns) == 0 {
+		return desired
+	}
+	result := make(map[string]interface{}, len(desired)+len(current))
+	for k, v := range desired {
+		result[k] = v
+	}
+	for k, v := range current {
+		if metadataKeyIgnored(patterns, k) {
+			result[k] = v
+		}
+	}
+	return result
+}
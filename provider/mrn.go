@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// assetMRN derives the MRN that the Marmot server assigns to a new asset. The
+// server builds it from the asset type, its first service and its name as
+// mrn://<type>/<service>/<name>, lowercasing the type and service and keeping
+// the name verbatim. An empty string is returned when any part is missing.
+func assetMRN(assetType string, services []string, name string) string {
+	if assetType == "" || len(services) == 0 || services[0] == "" || name == "" {
+		return ""
+	}
+	return fmt.Sprintf("mrn://%s/%s/%s", strings.ToLower(assetType), strings.ToLower(services[0]), name)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// Reserved metadata keys recording which Pulumi stack manages an asset. They
+// are written when provenance is enabled and are never shown in diffs.
+const (
+	provenanceProjectKey = "pulumi_project"
+	provenanceStackKey   = "pulumi_stack"
+	provenanceURNKey     = "pulumi_urn"
+	provenanceVersionKey = "pulumi_provider_version"
+)
+
+var provenanceKeys = []string{
+	provenanceProjectKey,
+	provenanceStackKey,
+	provenanceURNKey,
+	provenanceVersionKey,
+}
+
+type urnKey struct{}
+
+// withResourceURN makes the URN of the resource being created or updated
+// available to resource implementations through resourceURN.
+func withResourceURN(provider p.Provider) p.Provider {
+	create, update := provider.Create, provider.Update
+	provider.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+		return create(context.WithValue(ctx, urnKey{}, req.Urn), req)
+	}
+	provider.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+		return update(context.WithValue(ctx, urnKey{}, req.Urn), req)
+	}
+	return provider
+}
+
+func resourceURN(ctx context.Context) resource.URN {
+	urn, _ := ctx.Value(urnKey{}).(resource.URN)
+	return urn
+}
+
+// withProvenance returns a copy of metadata stamped with the project, stack and
+// URN of the resource in ctx and the provider version.
+func withProvenance(ctx context.Context, metadata map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(metadata)+len(provenanceKeys))
+	for k, v := range metadata {
+		result[k] = v
+	}
+
+	if urn := resourceURN(ctx); urn.IsValid() {
+		result[provenanceProjectKey] = urn.Project().String()
+		result[provenanceStackKey] = urn.Stack().String()
+		result[provenanceURNKey] = string(urn)
+	}
+	result[provenanceVersionKey] = Version
+	return result
+}
+
+type ListManagedAssets struct{}
+
+type ListManagedAssetsArgs struct {
+	Stack   string  `pulumi:"stack"`
+	Project *string `pulumi:"project,optional"`
+}
+
+type ManagedAsset struct {
+	ID   string `pulumi:"id"`
+	MRN  string `pulumi:"mrn"`
+	Name string `pulumi:"name"`
+	Type string `pulumi:"type"`
+	URN  string `pulumi:"urn"`
+}
+
+type ListManagedAssetsResult struct {
+	Assets []ManagedAsset `pulumi:"assets"`
+}
+
+func (ListManagedAssets) Call(ctx context.Context, args ListManagedAssetsArgs) (ListManagedAssetsResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return ListManagedAssetsResult{}, err
+	}
+
+	managed, _, err := listAssetPages(ctx, client, defaultListPageSize, defaultListParallelism,
+		func(asset *models.AssetAsset) (ManagedAsset, bool) {
+			metadata, _ := asset.Metadata.(map[string]interface{})
+			if metadata[provenanceStackKey] != args.Stack {
+				return ManagedAsset{}, false
+			}
+			if args.Project != nil && metadata[provenanceProjectKey] != *args.Project {
+				return ManagedAsset{}, false
+			}
+			urn, _ := metadata[provenanceURNKey].(string)
+			return ManagedAsset{
+				ID:   asset.ID,
+				MRN:  asset.Mrn,
+				Name: asset.Name,
+				Type: asset.Type,
+				URN:  urn,
+			}, true
+		})
+	if err != nil {
+		return ListManagedAssetsResult{}, err
+	}
+	return ListManagedAssetsResult{Assets: managed}, nil
+}
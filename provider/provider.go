@@ -19,10 +19,33 @@ var Version string
 const Name string = "marmot"
 
 func Provider() p.Provider {
-	return infer.Provider(infer.Options{
+	return withResourceURN(infer.Provider(infer.Options{
 		Resources: []infer.InferredResource{
 			infer.Resource[Asset](),
 			infer.Resource[Lineage](),
+			infer.Resource[AssetCollection](),
+			infer.Resource[LineageSet](),
+			infer.Resource[UpstreamLineage](),
+			infer.Resource[DocumentationSet](),
+		},
+		Components: []infer.InferredComponent{
+			infer.Component(NewDataPipeline),
+		},
+		Functions: []infer.InferredFunction{
+			infer.Function[ListManagedAssets](),
+			infer.Function[GetCatalogAsset](),
+			infer.Function[LookupAsset](),
+			infer.Function[SearchAssets](),
+			infer.Function[ListAssets](),
+			infer.Function[MatchAssets](),
+			infer.Function[GetAssetSummary](),
+			infer.Function[GetLineage](),
+			infer.Function[GetMetadataFieldSuggestions](),
+			infer.Function[GetMetadataValueSuggestions](),
+			infer.Function[GetTagSuggestions](),
+			infer.Function[GetCurrentUser](),
+			infer.Function[ListUsers](),
+			infer.Function[GetAuthConfig](),
 		},
 		Config: infer.Config[Config](),
 		ModuleMap: map[tokens.ModuleName]tokens.ModuleName{
@@ -42,12 +65,22 @@ func Provider() p.Provider {
 				},
 			},
 		},
-	})
+	}))
 }
 
 type Config struct {
-	Host   string `pulumi:"host"`
-	APIKey string `pulumi:"apiKey"`
+	Host               string                 `pulumi:"host"`
+	APIKey             string                 `pulumi:"apiKey"`
+	DefaultTags        []string               `pulumi:"defaultTags,optional"`
+	DefaultMetadata    map[string]interface{} `pulumi:"defaultMetadata,optional"`
+	IgnoreMetadataKeys []string               `pulumi:"ignoreMetadataKeys,optional"`
+	Provenance         bool                   `pulumi:"provenance,optional"`
+	StrictTags         string                 `pulumi:"strictTags,optional"`
+	AllowedTags        []string               `pulumi:"allowedTags,optional"`
+	FailFast           bool                   `pulumi:"failFast,optional"`
+	BatchCreates       bool                   `pulumi:"batchCreates,optional"`
+	BatchWindowMs      *int                   `pulumi:"batchWindowMs,optional"`
+	BatchSize          *int                   `pulumi:"batchSize,optional"`
 }
 
 func (c *Config) GetClient() (*client.Marmot, error) {
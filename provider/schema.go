@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// schemaSource is a schema document, read from schemaFile or marshaled from
+// the inline schema.
+type schemaSource struct {
+	content []byte
+	// path is empty for inline schemas.
+	path    string
+	message string
+}
+
+// schemaLoader validates a schema document and normalizes it into the map
+// stored as the asset's schema. The returned metadata is added to the asset's
+// metadata.
+type schemaLoader func(source schemaSource) (schema, metadata map[string]interface{}, err error)
+
+var schemaLoaders = map[string]schemaLoader{
+	"avro":       loadAvroSchema,
+	"jsonschema": loadJSONSchema,
+	"protobuf":   loadProtobufSchema,
+}
+
+// schemaExtensions maps file extensions to the format assumed when
+// schemaFormat is not set.
+var schemaExtensions = map[string]string{
+	".avsc":  "avro",
+	".json":  "jsonschema",
+	".proto": "protobuf",
+	".binpb": "protobuf",
+	".desc":  "protobuf",
+	".pb":    "protobuf",
+}
+
+// loadAssetSchema replaces the asset's schema with the normalized contents of
+// schemaFile, or validates and normalizes the inline schema when only
+// schemaFormat is set.
+func loadAssetSchema(args AssetArgs) (AssetArgs, []p.CheckFailure) {
+	if args.SchemaFile == nil && args.SchemaFormat == nil {
+		return args, nil
+	}
+
+	property := "schema"
+	if args.SchemaFile != nil {
+		property = "schemaFile"
+		if args.Schema != nil {
+			return args, []p.CheckFailure{{Property: "schemaFile", Reason: "schema and schemaFile cannot both be set"}}
+		}
+	}
+
+	format := stringValue(args.SchemaFormat)
+	if format == "" && args.SchemaFile != nil {
+		format = schemaExtensions[strings.ToLower(filepath.Ext(*args.SchemaFile))]
+		if format == "" {
+			return args, []p.CheckFailure{{
+				Property: "schemaFormat",
+				Reason:   fmt.Sprintf("cannot infer the format of %q, set schemaFormat to one of %s", *args.SchemaFile, schemaFormatNames()),
+			}}
+		}
+	}
+	load, ok := schemaLoaders[format]
+	if !ok {
+		return args, []p.CheckFailure{{
+			Property: "schemaFormat",
+			Reason:   fmt.Sprintf("unsupported schema format %q, expected one of %s", format, schemaFormatNames()),
+		}}
+	}
+
+	source := schemaSource{path: stringValue(args.SchemaFile), message: stringValue(args.SchemaMessage)}
+	var err error
+	if args.SchemaFile != nil {
+		source.content, err = os.ReadFile(*args.SchemaFile)
+	} else {
+		source.content, err = json.Marshal(args.Schema)
+	}
+	if err != nil {
+		return args, []p.CheckFailure{{Property: property, Reason: err.Error()}}
+	}
+
+	schema, metadata, err := load(source)
+	if err != nil {
+		return args, []p.CheckFailure{{Property: property, Reason: fmt.Sprintf("invalid %s schema: %s", format, err)}}
+	}
+	args.Schema = schema
+
+	// Metadata set on the asset takes precedence over the schema's.
+	if len(metadata) > 0 {
+		merged := make(map[string]interface{}, len(metadata)+len(args.Metadata))
+		for k, v := range metadata {
+			merged[k] = v
+		}
+		for k, v := range args.Metadata {
+			merged[k] = v
+		}
+		args.Metadata = merged
+	}
+	return args, nil
+}
+
+func schemaFormatNames() string {
+	names := make([]string, 0, len(schemaLoaders))
+	for name := range schemaLoaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
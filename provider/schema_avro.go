@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+var (
+	avroPrimitives = map[string]bool{
+		"null": true, "boolean": true, "int": true, "long": true,
+		"float": true, "double": true, "bytes": true, "string": true,
+	}
+	avroName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// avroLogicalTypes maps each logical type to the Avro types it annotates.
+	avroLogicalTypes = map[string][]string{
+		"decimal":                {"bytes", "fixed"},
+		"uuid":                   {"string", "fixed"},
+		"date":                   {"int"},
+		"time-millis":            {"int"},
+		"time-micros":            {"long"},
+		"timestamp-millis":       {"long"},
+		"timestamp-micros":       {"long"},
+		"timestamp-nanos":        {"long"},
+		"local-timestamp-millis": {"long"},
+		"local-timestamp-micros": {"long"},
+		"local-timestamp-nanos":  {"long"},
+		"duration":               {"fixed"},
+	}
+)
+
+// loadAvroSchema parses an Avro schema and normalizes it: named types are
+// referenced by their full name, primitives are written as plain strings and
+// logical types keep only the attributes they use.
+func loadAvroSchema(source schemaSource) (map[string]interface{}, map[string]interface{}, error) {
+	var document interface{}
+	if err := json.Unmarshal(source.content, &document); err != nil {
+		return nil, nil, err
+	}
+
+	parser := avroParser{named: map[string]string{}}
+	schema, err := parser.parse(document, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if m, ok := schema.(map[string]interface{}); ok {
+		return m, nil, nil
+	}
+	return map[string]interface{}{"type": schema}, nil, nil
+}
+
+type avroParser struct {
+	// named records the type of each named type defined so far, by full name.
+	named map[string]string
+}
+
+func (a avroParser) parse(schema interface{}, namespace string) (interface{}, error) {
+	switch s := schema.(type) {
+	case string:
+		return a.reference(s, namespace)
+	case []interface{}:
+		return a.parseUnion(s, namespace)
+	case map[string]interface{}:
+		if _, ok := s["logicalType"]; ok {
+			return a.parseLogical(s, namespace)
+		}
+		typ, ok := s["type"].(string)
+		if !ok {
+			return nil, fmt.Errorf("type must be a string, got %v", s["type"])
+		}
+		switch typ {
+		case "record", "error":
+			return a.parseRecord(s, namespace)
+		case "enum":
+			return a.parseEnum(s, namespace)
+		case "fixed":
+			return a.parseFixed(s, namespace)
+		case "array":
+			items, err := a.parse(s["items"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("array items: %w", err)
+			}
+			return map[string]interface{}{"type": "array", "items": items}, nil
+		case "map":
+			values, err := a.parse(s["values"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("map values: %w", err)
+			}
+			return map[string]interface{}{"type": "map", "values": values}, nil
+		default:
+			return a.reference(typ, namespace)
+		}
+	case nil:
+		return nil, fmt.Errorf("missing type")
+	}
+	return nil, fmt.Errorf("invalid schema %v", schema)
+}
+
+// reference resolves a primitive or a previously defined named type.
+func (a avroParser) reference(name, namespace string) (interface{}, error) {
+	if avroPrimitives[name] {
+		return name, nil
+	}
+	fullName := avroFullName(name, namespace)
+	if _, ok := a.named[fullName]; ok {
+		return fullName, nil
+	}
+	if _, ok := a.named[name]; ok {
+		return name, nil
+	}
+	return nil, fmt.Errorf("unknown type %q", name)
+}
+
+func (a avroParser) parseUnion(branches []interface{}, namespace string) (interface{}, error) {
+	if len(branches) == 0 {
+		return nil, fmt.Errorf("union has no branches")
+	}
+
+	result := make([]interface{}, len(branches))
+	seen := map[string]bool{}
+	for i, branch := range branches {
+		if _, nested := branch.([]interface{}); nested {
+			return nil, fmt.Errorf("union branch %d: unions may not contain unions", i)
+		}
+		parsed, err := a.parse(branch, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("union branch %d: %w", i, err)
+		}
+		key := avroUnionKey(parsed)
+		if seen[key] {
+			return nil, fmt.Errorf("union contains %q more than once", key)
+		}
+		seen[key] = true
+		result[i] = parsed
+	}
+	return result, nil
+}
+
+// avroUnionKey identifies a union branch. Named types are distinguished by
+// name and all other types by their type.
+func avroUnionKey(schema interface{}) string {
+	switch s := schema.(type) {
+	case string:
+		return s
+	case map[string]interface{}:
+		if name, ok := s["name"].(string); ok {
+			return avroFullName(name, stringAttribute(s, "namespace"))
+		}
+		return s["type"].(string)
+	}
+	return fmt.Sprint(schema)
+}
+
+// define validates the name of a named type and registers it, returning its
+// normalized name attributes and the namespace of its children.
+func (a avroParser) define(s map[string]interface{}, namespace string) (map[string]interface{}, string, error) {
+	name, ok := s["name"].(string)
+	if !ok || name == "" {
+		return nil, "", fmt.Errorf("%s is missing a name", s["type"])
+	}
+	if ns, ok := s["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	fullName := avroFullName(name, namespace)
+	for _, part := range strings.Split(fullName, ".") {
+		if !avroName.MatchString(part) {
+			return nil, "", fmt.Errorf("invalid name %q", fullName)
+		}
+	}
+	if _, exists := a.named[fullName]; exists {
+		return nil, "", fmt.Errorf("type %q is defined more than once", fullName)
+	}
+	a.named[fullName] = s["type"].(string)
+
+	result := map[string]interface{}{"type": s["type"]}
+	if i := strings.LastIndex(fullName, "."); i >= 0 {
+		result["name"] = fullName[i+1:]
+		result["namespace"] = fullName[:i]
+		namespace = fullName[:i]
+	} else {
+		result["name"] = fullName
+		namespace = ""
+	}
+	if doc, ok := s["doc"].(string); ok {
+		result["doc"] = doc
+	}
+	if aliases, ok := s["aliases"].([]interface{}); ok && len(aliases) > 0 {
+		result["aliases"] = aliases
+	}
+	return result, namespace, nil
+}
+
+func (a avroParser) parseRecord(s map[string]interface{}, namespace string) (interface{}, error) {
+	result, namespace, err := a.define(s, namespace)
+	if err != nil {
+		return nil, err
+	}
+	fields, ok := s["fields"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("record %q has no fields", result["name"])
+	}
+
+	normalized := make([]interface{}, len(fields))
+	seen := map[string]bool{}
+	for i, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("record %q: field %d is not an object", result["name"], i)
+		}
+		name, _ := field["name"].(string)
+		if !avroName.MatchString(name) {
+			return nil, fmt.Errorf("record %q: invalid field name %q", result["name"], name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("record %q: field %q is defined more than once", result["name"], name)
+		}
+		seen[name] = true
+
+		typ, err := a.parse(field["type"], namespace)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+		out := map[string]interface{}{"name": name, "type": typ}
+		for _, key := range []string{"doc", "default", "aliases"} {
+			if v, ok := field[key]; ok {
+				out[key] = v
+			}
+		}
+		if order, ok := field["order"]; ok {
+			if order != "ascending" && order != "descending" && order != "ignore" {
+				return nil, fmt.Errorf("field %q: invalid order %v", name, order)
+			}
+			out["order"] = order
+		}
+		normalized[i] = out
+	}
+	result["fields"] = normalized
+	return result, nil
+}
+
+func (a avroParser) parseEnum(s map[string]interface{}, namespace string) (interface{}, error) {
+	result, _, err := a.define(s, namespace)
+	if err != nil {
+		return nil, err
+	}
+	symbols, ok := s["symbols"].([]interface{})
+	if !ok || len(symbols) == 0 {
+		return nil, fmt.Errorf("enum %q has no symbols", result["name"])
+	}
+
+	seen := map[string]bool{}
+	for _, symbol := range symbols {
+		name, _ := symbol.(string)
+		if !avroName.MatchString(name) {
+			return nil, fmt.Errorf("enum %q: invalid symbol %v", result["name"], symbol)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("enum %q: symbol %q is defined more than once", result["name"], name)
+		}
+		seen[name] = true
+	}
+	result["symbols"] = symbols
+
+	if def, ok := s["default"]; ok {
+		if name, _ := def.(string); !seen[name] {
+			return nil, fmt.Errorf("enum %q: default %v is not a symbol", result["name"], def)
+		}
+		result["default"] = def
+	}
+	return result, nil
+}
+
+func (a avroParser) parseFixed(s map[string]interface{}, namespace string) (interface{}, error) {
+	result, _, err := a.define(s, namespace)
+	if err != nil {
+		return nil, err
+	}
+	size, ok := s["size"].(float64)
+	if !ok || size < 1 || size != math.Trunc(size) {
+		return nil, fmt.Errorf("fixed %q: size must be a positive integer", result["name"])
+	}
+	result["size"] = size
+	return result, nil
+}
+
+// parseLogical validates a logical type against the type it annotates. As the
+// Avro specification requires, unknown or invalid logical types are ignored and
+// the annotated type is used as it is.
+func (a avroParser) parseLogical(s map[string]interface{}, namespace string) (interface{}, error) {
+	base := make(map[string]interface{}, len(s))
+	for k, v := range s {
+		if k != "logicalType" && k != "precision" && k != "scale" {
+			base[k] = v
+		}
+	}
+	parsed, err := a.parse(base, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	logicalType, _ := s["logicalType"].(string)
+	result, ok := parsed.(map[string]interface{})
+	if !ok {
+		result = map[string]interface{}{"type": parsed}
+	}
+	typ, _ := result["type"].(string)
+	if !containsString(avroLogicalTypes[logicalType], typ) {
+		return parsed, nil
+	}
+
+	switch logicalType {
+	case "decimal":
+		precision, ok := s["precision"].(float64)
+		if !ok || precision < 1 || precision != math.Trunc(precision) {
+			return parsed, nil
+		}
+		if typ == "fixed" {
+			size := result["size"].(float64)
+			if limit := math.Floor(math.Log10(2) * (8*size - 1)); precision > limit {
+				return parsed, nil
+			}
+		}
+		if scale, ok := s["scale"]; ok {
+			value, ok := scale.(float64)
+			if !ok || value < 0 || value > precision || value != math.Trunc(value) {
+				return parsed, nil
+			}
+			result["scale"] = value
+		}
+		result["precision"] = precision
+	case "uuid":
+		if typ == "fixed" && result["size"].(float64) != 16 {
+			return parsed, nil
+		}
+	case "duration":
+		if result["size"].(float64) != 12 {
+			return parsed, nil
+		}
+	}
+	result["logicalType"] = logicalType
+	return result, nil
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func stringAttribute(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
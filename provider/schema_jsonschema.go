@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const jsonSchemaURL = "mem://schema.json"
+
+// jsonSchemaDataKeywords hold instance values rather than subschemas, so they
+// are copied as they are.
+var jsonSchemaDataKeywords = map[string]bool{
+	"enum": true, "const": true, "default": true, "examples": true,
+}
+
+// jsonSchemaMapKeywords map names to subschemas. Their keys are names, such as
+// property names, and never keywords.
+var jsonSchemaMapKeywords = map[string]bool{
+	"properties": true, "patternProperties": true, "$defs": true, "definitions": true,
+	"dependentSchemas": true, "dependencies": true,
+}
+
+// jsonSchemaLegacyDrafts ignore the keywords next to a $ref.
+var jsonSchemaLegacyDrafts = []string{"draft-04", "draft-06", "draft-07"}
+
+// loadJSONSchema validates a JSON Schema document against the meta-schema of
+// its draft and inlines its local $refs, so that every property carries its
+// type and required list. $defs are dropped once nothing refers to them;
+// recursive references are kept as they are.
+func loadJSONSchema(source schemaSource) (map[string]interface{}, map[string]interface{}, error) {
+	var document interface{}
+	if err := json.Unmarshal(source.content, &document); err != nil {
+		return nil, nil, err
+	}
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("document must be an object")
+	}
+
+	resolver := jsonSchemaResolver{root: root, anchors: map[string]interface{}{}, mergeRefSiblings: true}
+	if draft, ok := root["$schema"].(string); ok {
+		for _, legacy := range jsonSchemaLegacyDrafts {
+			if strings.Contains(draft, legacy) {
+				resolver.mergeRefSiblings = false
+			}
+		}
+	}
+	resolver.collectAnchors(root)
+	resolved, err := resolver.resolve(root, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(jsonSchemaURL, bytes.NewReader(source.content)); err != nil {
+		return nil, nil, err
+	}
+	if _, err := compiler.Compile(jsonSchemaURL); err != nil {
+		return nil, nil, jsonSchemaError(err)
+	}
+
+	schema := resolved.(map[string]interface{})
+	if !resolver.recursive {
+		delete(schema, "$defs")
+		delete(schema, "definitions")
+	}
+	return schema, nil, nil
+}
+
+type jsonSchemaResolver struct {
+	root    map[string]interface{}
+	anchors map[string]interface{}
+	// mergeRefSiblings is set from draft 2019-09 on, where the keywords next
+	// to a $ref apply along with it.
+	mergeRefSiblings bool
+	recursive        bool
+}
+
+func (r *jsonSchemaResolver) collectAnchors(node interface{}) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if anchor, ok := n["$anchor"].(string); ok {
+			r.anchors[anchor] = n
+		}
+		// Draft-07 declares anchors as plain-name fragments in $id.
+		if id, ok := n["$id"].(string); ok && strings.HasPrefix(id, "#") {
+			r.anchors[id[1:]] = n
+		}
+		for k, v := range n {
+			switch {
+			case jsonSchemaDataKeywords[k]:
+			case jsonSchemaMapKeywords[k]:
+				if schemas, ok := v.(map[string]interface{}); ok {
+					for _, schema := range schemas {
+						r.collectAnchors(schema)
+					}
+				}
+			default:
+				r.collectAnchors(v)
+			}
+		}
+	case []interface{}:
+		for _, v := range n {
+			r.collectAnchors(v)
+		}
+	}
+}
+
+// resolve returns a copy of node with its $refs replaced by the schemas they
+// point to. stack holds the references being resolved, to detect recursion.
+func (r *jsonSchemaResolver) resolve(node interface{}, stack []string) (interface{}, error) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			if k == "$ref" || jsonSchemaDataKeywords[k] {
+				result[k] = v
+				continue
+			}
+			var resolved interface{}
+			var err error
+			if jsonSchemaMapKeywords[k] {
+				resolved, err = r.resolveMap(v, stack)
+			} else {
+				resolved, err = r.resolve(v, stack)
+			}
+			if err != nil {
+				return nil, err
+			}
+			result[k] = resolved
+		}
+
+		ref, ok := n["$ref"].(string)
+		if !ok {
+			return result, nil
+		}
+		if !strings.HasPrefix(ref, "#") {
+			return nil, fmt.Errorf("$ref %q is not local, only references within the document are supported", ref)
+		}
+		if containsString(stack, ref) {
+			r.recursive = true
+			return result, nil
+		}
+
+		target, err := r.lookup(ref)
+		if err != nil {
+			return nil, err
+		}
+		resolved, err := r.resolve(target, append(stack, ref))
+		if err != nil {
+			return nil, err
+		}
+
+		// Keywords next to $ref, such as a description, override the ones of
+		// the referenced schema. Drafts before 2019-09 ignore them.
+		delete(result, "$ref")
+		if len(result) == 0 || !r.mergeRefSiblings {
+			return resolved, nil
+		}
+		if m, ok := resolved.(map[string]interface{}); ok {
+			for k, v := range m {
+				if _, exists := result[k]; !exists {
+					result[k] = v
+				}
+			}
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(n))
+		for i, v := range n {
+			resolved, err := r.resolve(v, stack)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = resolved
+		}
+		return result, nil
+	}
+	return node, nil
+}
+
+// resolveMap resolves each subschema of a keyword such as properties. Values
+// that are not subschemas, such as the property lists of dependencies, are
+// resolved as they are.
+func (r *jsonSchemaResolver) resolveMap(node interface{}, stack []string) (interface{}, error) {
+	schemas, ok := node.(map[string]interface{})
+	if !ok {
+		return r.resolve(node, stack)
+	}
+	result := make(map[string]interface{}, len(schemas))
+	for name, schema := range schemas {
+		resolved, err := r.resolve(schema, stack)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = resolved
+	}
+	return result, nil
+}
+
+// lookup finds the target of a local reference, either a JSON pointer such as
+// #/$defs/address or an anchor such as #address.
+func (r *jsonSchemaResolver) lookup(ref string) (interface{}, error) {
+	fragment, err := url.PathUnescape(strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return nil, fmt.Errorf("$ref %q: %w", ref, err)
+	}
+	if fragment == "" {
+		return r.root, nil
+	}
+	if !strings.HasPrefix(fragment, "/") {
+		if target, ok := r.anchors[fragment]; ok {
+			return target, nil
+		}
+		return nil, fmt.Errorf("$ref %q cannot be resolved", ref)
+	}
+
+	var node interface{} = r.root
+	for _, token := range strings.Split(fragment[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch n := node.(type) {
+		case map[string]interface{}:
+			next, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("$ref %q cannot be resolved", ref)
+			}
+			node = next
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, fmt.Errorf("$ref %q cannot be resolved", ref)
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("$ref %q cannot be resolved", ref)
+		}
+	}
+	return node, nil
+}
+
+// jsonSchemaError reports each meta-schema violation at the location in the
+// document where it occurred.
+func jsonSchemaError(err error) error {
+	var validation *jsonschema.ValidationError
+	if !errors.As(err, &validation) {
+		var schemaErr *jsonschema.SchemaError
+		if errors.As(err, &schemaErr) {
+			return schemaErr.Err
+		}
+		return err
+	}
+
+	var messages []string
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			location := e.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			messages = append(messages, fmt.Sprintf("%s: %s", location, e.Message))
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(validation)
+	sort.Strings(messages)
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// loadProtobufSchema reads a .proto file, compiling it together with the files
+// it imports from its directory, or a compiled FileDescriptorSet. The message
+// named by schemaMessage is flattened into a list of fields, where the fields
+// of nested messages are named by their path, such as shipping.city.
+func loadProtobufSchema(source schemaSource) (map[string]interface{}, map[string]interface{}, error) {
+	if source.path == "" {
+		return nil, nil, fmt.Errorf("protobuf schemas must be read from schemaFile")
+	}
+
+	var files []protoreflect.FileDescriptor
+	var err error
+	if strings.EqualFold(filepath.Ext(source.path), ".proto") {
+		files, err = compileProtoFile(source.path)
+	} else {
+		files, err = readFileDescriptorSet(source.content)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	message, err := findProtoMessage(files, source.message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := message.ParentFile()
+	schema := map[string]interface{}{
+		"type":    "protobuf",
+		"message": string(message.FullName()),
+		"package": string(file.Package()),
+		"syntax":  file.Syntax().String(),
+		"fields":  flattenProtoFields(message, "", nil),
+	}
+	metadata := map[string]interface{}{
+		"proto_package": string(file.Package()),
+		"proto_syntax":  file.Syntax().String(),
+	}
+	return schema, metadata, nil
+}
+
+func compileProtoFile(path string) ([]protoreflect.FileDescriptor, error) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{filepath.Dir(path)},
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	compiled, err := compiler.Compile(context.Background(), filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+	return []protoreflect.FileDescriptor{compiled[0]}, nil
+}
+
+// readFileDescriptorSet returns the files of the set that no other file in the
+// set imports, which are the ones it was compiled for.
+func readFileDescriptorSet(content []byte) ([]protoreflect.FileDescriptor, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		return nil, fmt.Errorf("reading FileDescriptorSet: %w", err)
+	}
+	registry, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("reading FileDescriptorSet: %w", err)
+	}
+
+	imported := map[string]bool{}
+	for _, file := range set.File {
+		for _, dependency := range file.Dependency {
+			imported[dependency] = true
+		}
+	}
+
+	var files []protoreflect.FileDescriptor
+	for _, file := range set.File {
+		if imported[file.GetName()] {
+			continue
+		}
+		descriptor, err := registry.FindFileByPath(file.GetName())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, descriptor)
+	}
+	return files, nil
+}
+
+// findProtoMessage looks up a message by its fully qualified name. Without a
+// name, the files must define exactly one top-level message.
+func findProtoMessage(files []protoreflect.FileDescriptor, name string) (protoreflect.MessageDescriptor, error) {
+	var candidates []protoreflect.MessageDescriptor
+	var walk func(protoreflect.MessageDescriptors)
+	walk = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			candidates = append(candidates, messages.Get(i))
+			walk(messages.Get(i).Messages())
+		}
+	}
+	var topLevel []string
+	for _, file := range files {
+		for i := 0; i < file.Messages().Len(); i++ {
+			topLevel = append(topLevel, string(file.Messages().Get(i).FullName()))
+		}
+		walk(file.Messages())
+	}
+	sort.Strings(topLevel)
+
+	if name == "" {
+		if len(topLevel) != 1 {
+			return nil, fmt.Errorf("set schemaMessage to one of %s", strings.Join(topLevel, ", "))
+		}
+		name = topLevel[0]
+	}
+	for _, message := range candidates {
+		if string(message.FullName()) == strings.TrimPrefix(name, ".") && !message.IsMapEntry() {
+			return message, nil
+		}
+	}
+	return nil, fmt.Errorf("message %q not found, expected one of %s", name, strings.Join(topLevel, ", "))
+}
+
+// flattenProtoFields lists the fields of a message. Fields of message type are
+// followed by their own fields, unless the message is already being expanded
+// or is a well-known type.
+func flattenProtoFields(message protoreflect.MessageDescriptor, prefix string, expanding []protoreflect.FullName) []interface{} {
+	expanding = append(expanding, message.FullName())
+
+	fields := []interface{}{}
+	for i := 0; i < message.Fields().Len(); i++ {
+		field := message.Fields().Get(i)
+		name := prefix + string(field.Name())
+		entry := map[string]interface{}{
+			"name":   name,
+			"number": int(field.Number()),
+			"type":   protoTypeName(field),
+		}
+
+		switch {
+		case field.IsList():
+			entry["label"] = "repeated"
+		case field.Cardinality() == protoreflect.Required:
+			entry["label"] = "required"
+		case field.HasOptionalKeyword():
+			entry["label"] = "optional"
+		}
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			entry["oneof"] = string(oneof.Name())
+		}
+
+		value := field
+		if field.IsMap() {
+			entry["keyType"] = protoTypeName(field.MapKey())
+			entry["valueType"] = protoTypeName(field.MapValue())
+			value = field.MapValue()
+			name += ".value"
+		}
+		if value.Kind() == protoreflect.EnumKind {
+			entry["symbols"] = protoEnumSymbols(value.Enum())
+		}
+		if doc := protoComment(field); doc != "" {
+			entry["doc"] = doc
+		}
+		fields = append(fields, entry)
+
+		if nested := value.Message(); nested != nil && !isWellKnownProto(nested) && !containsFullName(expanding, nested.FullName()) {
+			fields = append(fields, flattenProtoFields(nested, name+".", expanding)...)
+		}
+	}
+	return fields
+}
+
+func protoTypeName(field protoreflect.FieldDescriptor) string {
+	switch {
+	case field.IsMap():
+		return fmt.Sprintf("map<%s, %s>", protoTypeName(field.MapKey()), protoTypeName(field.MapValue()))
+	case field.Kind() == protoreflect.EnumKind:
+		return string(field.Enum().FullName())
+	case field.Message() != nil:
+		return string(field.Message().FullName())
+	}
+	return field.Kind().String()
+}
+
+func protoEnumSymbols(enum protoreflect.EnumDescriptor) []interface{} {
+	symbols := make([]interface{}, enum.Values().Len())
+	for i := range symbols {
+		symbols[i] = string(enum.Values().Get(i).Name())
+	}
+	return symbols
+}
+
+func protoComment(descriptor protoreflect.Descriptor) string {
+	location := descriptor.ParentFile().SourceLocations().ByDescriptor(descriptor)
+	return strings.TrimSpace(location.LeadingComments)
+}
+
+func isWellKnownProto(message protoreflect.MessageDescriptor) bool {
+	return strings.HasPrefix(string(message.FullName()), "google.protobuf.")
+}
+
+func containsFullName(names []protoreflect.FullName, name protoreflect.FullName) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetMetadataFieldSuggestions struct{}
+
+type GetMetadataFieldSuggestionsArgs struct {
+	Prefix *string `pulumi:"prefix,optional"`
+	Limit  *int    `pulumi:"limit,optional"`
+}
+
+type MetadataFieldSuggestion struct {
+	Field     string      `pulumi:"field"`
+	PathParts []string    `pulumi:"pathParts"`
+	Type      string      `pulumi:"type"`
+	Types     []string    `pulumi:"types"`
+	Count     int         `pulumi:"count"`
+	Example   interface{} `pulumi:"example,optional"`
+}
+
+type GetMetadataFieldSuggestionsResult struct {
+	Fields []MetadataFieldSuggestion `pulumi:"fields"`
+}
+
+func (GetMetadataFieldSuggestions) Call(ctx context.Context, args GetMetadataFieldSuggestionsArgs) (GetMetadataFieldSuggestionsResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetMetadataFieldSuggestionsResult{}, err
+	}
+
+	result, err := client.Assets.GetAssetsSuggestionsMetadataFields(assets.NewGetAssetsSuggestionsMetadataFieldsParamsWithContext(ctx))
+	if err != nil {
+		return GetMetadataFieldSuggestionsResult{}, fmt.Errorf("reading metadata field suggestions: %w", err)
+	}
+
+	// The endpoint returns every known field, so prefix and limit are applied
+	// here, keeping the most used fields first.
+	fields := make([]MetadataFieldSuggestion, 0, len(result.Payload))
+	for _, field := range result.Payload {
+		if args.Prefix != nil && !strings.HasPrefix(field.Field, *args.Prefix) {
+			continue
+		}
+		fields = append(fields, MetadataFieldSuggestion{
+			Field:     field.Field,
+			PathParts: field.PathParts,
+			Type:      field.Type,
+			Types:     field.Types,
+			Count:     int(field.Count),
+			Example:   field.Example,
+		})
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].Count != fields[j].Count {
+			return fields[i].Count > fields[j].Count
+		}
+		return fields[i].Field < fields[j].Field
+	})
+	if args.Limit != nil && *args.Limit < len(fields) {
+		fields = fields[:max(0, *args.Limit)]
+	}
+
+	return GetMetadataFieldSuggestionsResult{Fields: fields}, nil
+}
+
+type GetMetadataValueSuggestions struct{}
+
+type GetMetadataValueSuggestionsArgs struct {
+	Field  string  `pulumi:"field"`
+	Prefix *string `pulumi:"prefix,optional"`
+	Limit  *int    `pulumi:"limit,optional"`
+}
+
+type MetadataValueSuggestion struct {
+	Value      string `pulumi:"value"`
+	Count      int    `pulumi:"count"`
+	ExampleMRN string `pulumi:"exampleMrn,optional"`
+}
+
+type GetMetadataValueSuggestionsResult struct {
+	Values []MetadataValueSuggestion `pulumi:"values"`
+}
+
+func (GetMetadataValueSuggestions) Call(ctx context.Context, args GetMetadataValueSuggestionsArgs) (GetMetadataValueSuggestionsResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetMetadataValueSuggestionsResult{}, err
+	}
+
+	params := assets.NewGetAssetsSuggestionsMetadataValuesParamsWithContext(ctx).
+		WithField(args.Field).
+		WithPrefix(args.Prefix)
+	if args.Limit != nil {
+		limit := int64(*args.Limit)
+		params = params.WithLimit(&limit)
+	}
+
+	result, err := client.Assets.GetAssetsSuggestionsMetadataValues(params)
+	if err != nil {
+		return GetMetadataValueSuggestionsResult{}, fmt.Errorf("reading value suggestions for metadata field %q: %w", args.Field, err)
+	}
+
+	values := make([]MetadataValueSuggestion, 0, len(result.Payload))
+	for _, value := range result.Payload {
+		values = append(values, MetadataValueSuggestion{
+			Value:      value.Value,
+			Count:      int(value.Count),
+			ExampleMRN: value.Example.Mrn,
+		})
+	}
+	return GetMetadataValueSuggestionsResult{Values: values}, nil
+}
+
+type GetTagSuggestions struct{}
+
+type GetTagSuggestionsArgs struct {
+	Prefix *string `pulumi:"prefix,optional"`
+	Limit  *int    `pulumi:"limit,optional"`
+}
+
+type GetTagSuggestionsResult struct {
+	Tags []string `pulumi:"tags"`
+}
+
+func (GetTagSuggestions) Call(ctx context.Context, args GetTagSuggestionsArgs) (GetTagSuggestionsResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetTagSuggestionsResult{}, err
+	}
+
+	tags, err := tagSuggestions(ctx, client.Assets, args.Prefix, intValue(args.Limit, 10))
+	if err != nil {
+		return GetTagSuggestionsResult{}, err
+	}
+	return GetTagSuggestionsResult{Tags: tags}, nil
+}
+
+func tagSuggestions(ctx context.Context, client assets.ClientService, prefix *string, limit int) ([]string, error) {
+	l := int64(limit)
+	params := assets.NewGetAssetsSuggestionsTagsParamsWithContext(ctx).
+		WithPrefix(prefix).
+		WithLimit(&l)
+
+	result, err := client.GetAssetsSuggestionsTags(params)
+	if err != nil {
+		return nil, fmt.Errorf("reading tag suggestions: %w", err)
+	}
+	if result.Payload == nil {
+		return []string{}, nil
+	}
+	return result.Payload, nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+const (
+	strictTagsWarn  = "warn"
+	strictTagsError = "error"
+
+	// tagVocabularyLimit is the number of existing tags read from the catalog
+	// in one request.
+	tagVocabularyLimit = 1000
+)
+
+// tagVocabulary caches the catalog's existing tags for a provider
+// configuration, so that they are read once rather than on every Check.
+type tagVocabulary struct {
+	mu     sync.Mutex
+	tags   []string
+	loaded bool
+	// exists records the tags looked up individually because they were not
+	// among the tags read at once.
+	exists map[string]bool
+}
+
+type tagVocabularyKey struct {
+	host, apiKey string
+}
+
+var tagVocabularies sync.Map
+
+func tagVocabularyFor(config Config) *tagVocabulary {
+	vocabulary, _ := tagVocabularies.LoadOrStore(tagVocabularyKey{config.Host, config.APIKey}, &tagVocabulary{exists: map[string]bool{}})
+	return vocabulary.(*tagVocabulary)
+}
+
+// load returns the catalog's tags, up to tagVocabularyLimit of them.
+func (v *tagVocabulary) load(ctx context.Context, client assets.ClientService) ([]string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if !v.loaded {
+		tags, err := tagSuggestions(ctx, client, nil, tagVocabularyLimit)
+		if err != nil {
+			return nil, err
+		}
+		v.tags, v.loaded = tags, true
+	}
+	return v.tags, nil
+}
+
+// contains reports whether the catalog has the tag. The suggestions endpoint
+// cannot page past its limit, so when the vocabulary fills a whole page, a tag
+// missing from it is looked up by using it as the prefix.
+func (v *tagVocabulary) contains(ctx context.Context, client assets.ClientService, tag string) (bool, error) {
+	tags, err := v.load(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	if containsString(tags, tag) {
+		return true, nil
+	}
+	if len(tags) < tagVocabularyLimit {
+		return false, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if exists, ok := v.exists[tag]; ok {
+		return exists, nil
+	}
+	matches, err := tagSuggestions(ctx, client, &tag, tagVocabularyLimit)
+	if err != nil {
+		return false, err
+	}
+	v.exists[tag] = containsString(matches, tag)
+	return v.exists[tag], nil
+}
+
+// checkTagVocabulary reports the asset tags that are neither in the catalog's
+// existing tags nor in the provider's allowed or default tags. Depending on
+// the strictTags setting, they are logged as warnings or returned as failures.
+func checkTagVocabulary(ctx context.Context, config Config, tags []string) ([]p.CheckFailure, error) {
+	if config.StrictTags == "" || len(tags) == 0 {
+		return nil, nil
+	}
+
+	client, err := config.GetClient()
+	if err != nil {
+		return nil, err
+	}
+	catalog := tagVocabularyFor(config)
+	existing, err := catalog.load(ctx, client.Assets)
+	if err != nil {
+		return nil, err
+	}
+	vocabulary := append([]string{}, existing...)
+	vocabulary = append(vocabulary, config.AllowedTags...)
+	vocabulary = append(vocabulary, config.DefaultTags...)
+
+	var failures []p.CheckFailure
+	for _, tag := range tags {
+		if containsString(vocabulary, tag) {
+			continue
+		}
+		exists, err := catalog.contains(ctx, client.Assets, tag)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		reason := fmt.Sprintf("tag %q is not in the catalog's tag vocabulary", tag)
+		if closest := closestTag(tag, vocabulary); closest != "" {
+			reason += fmt.Sprintf(", did you mean %q?", closest)
+		}
+		if config.StrictTags == strictTagsWarn {
+			p.GetLogger(ctx).Warning(reason)
+			continue
+		}
+		failures = append(failures, p.CheckFailure{Property: "tags", Reason: reason})
+	}
+	return failures, nil
+}
+
+// closestTag returns the vocabulary tag nearest to tag. Tags that only differ
+// in case or punctuation, such as "PII" and "p-i-i", match "pii" exactly;
+// otherwise the tag with the smallest edit distance is chosen, provided it is
+// within half the length of the tag.
+func closestTag(tag string, vocabulary []string) string {
+	normalized := normalizeTag(tag)
+	closest, best := "", len([]rune(normalized))/2+1
+	for _, candidate := range vocabulary {
+		distance := levenshtein(normalized, normalizeTag(candidate))
+		if distance < best {
+			closest, best = candidate, distance
+		}
+	}
+	return closest
+}
+
+func normalizeTag(tag string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, tag)
+}
+
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	row := make([]int, len(t)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current := row[j]
+			row[j] = min(row[j]+1, row[j-1]+1, prev+cost)
+			prev = current
+		}
+	}
+	return row[len(t)]
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/assets"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/lineage"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// UpstreamLineage owns every upstream edge of a target asset. Edges into the
+// target from sources that are not declared, such as ones drawn by hand or by
+// stale jobs, are removed on the next update.
+type UpstreamLineage struct{}
+
+type UpstreamLineageArgs struct {
+	Target  string   `pulumi:"target"`
+	Sources []string `pulumi:"sources"`
+	JobMRN  string   `pulumi:"jobMrn,optional"`
+}
+
+type UpstreamEdge struct {
+	Source     string `pulumi:"source"`
+	ResourceID string `pulumi:"resourceId"`
+	JobMRN     string `pulumi:"jobMrn,optional"`
+	// Created is set for the edges this resource created, which are the only
+	// ones it removes on delete.
+	Created bool `pulumi:"created,optional"`
+}
+
+type UpstreamLineageState struct {
+	UpstreamLineageArgs
+	TargetID string `pulumi:"targetId"`
+	// Edges are keyed by upstreamEdgeKey, so that each edge is addressed by
+	// its source and job.
+	Edges map[string]UpstreamEdge `pulumi:"edges"`
+}
+
+// upstreamEdgeKey identifies an edge by its source, followed by its job when
+// it has one, such as mrn://topic/kafka/orders|mrn://job/airflow/load.
+func upstreamEdgeKey(source, jobMRN string) string {
+	if jobMRN == "" {
+		return source
+	}
+	return source + "|" + jobMRN
+}
+
+// upstreamPlan compares the target's current upstream edges with the declared
+// sources.
+type upstreamPlan struct {
+	targetID string
+	kept     []UpstreamEdge
+	stale    []UpstreamEdge
+	missing  []string
+}
+
+func planUpstreamLineage(ctx context.Context, client *client.Marmot, args UpstreamLineageArgs) (upstreamPlan, error) {
+	var plan upstreamPlan
+	if args.Target == "" {
+		// The target MRN is not known yet during preview.
+		plan.missing = append(plan.missing, args.Sources...)
+		return plan, nil
+	}
+
+	params := assets.NewGetAssetsQualifiedNameQualifiedNameParamsWithContext(ctx).WithQualifiedName(args.Target)
+	target, err := client.Assets.GetAssetsQualifiedNameQualifiedName(params)
+	if err != nil {
+		var notFound *assets.GetAssetsQualifiedNameQualifiedNameNotFound
+		if errors.As(err, &notFound) {
+			// The target has not been created yet, so it has no edges.
+			plan.missing = append(plan.missing, args.Sources...)
+			return plan, nil
+		}
+		return plan, fmt.Errorf("reading target asset %q: %w", args.Target, err)
+	}
+	plan.targetID = target.Payload.ID
+
+	depth := 1
+	current, err := readLineage(ctx, client.Lineage, plan.targetID, "upstream", &depth)
+	if err != nil {
+		return plan, err
+	}
+
+	linked := map[string]bool{}
+	for _, edge := range current.Edges {
+		if edge == nil || edge.Target != args.Target {
+			continue
+		}
+		upstream := UpstreamEdge{Source: edge.Source, ResourceID: edge.ID, JobMRN: edge.JobMrn}
+		declared := containsString(args.Sources, edge.Source) && (args.JobMRN == "" || edge.JobMrn == args.JobMRN)
+		if declared && !linked[edge.Source] {
+			plan.kept = append(plan.kept, upstream)
+			linked[edge.Source] = true
+		} else {
+			plan.stale = append(plan.stale, upstream)
+		}
+	}
+	for _, source := range args.Sources {
+		if !linked[source] && !containsString(plan.missing, source) {
+			plan.missing = append(plan.missing, source)
+		}
+	}
+	return plan, nil
+}
+
+// Diff compares the declared sources with the edges currently in the catalog,
+// so that edges added or removed outside of Pulumi are reported as changes.
+func (UpstreamLineage) Diff(ctx context.Context, id string, olds UpstreamLineageState, news UpstreamLineageArgs) (p.DiffResponse, error) {
+	detailedDiff := map[string]p.PropertyDiff{}
+	if olds.Target != news.Target {
+		detailedDiff["target"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if olds.JobMRN != news.JobMRN {
+		detailedDiff["jobMrn"] = p.PropertyDiff{Kind: p.Update}
+	}
+	for i, source := range news.Sources {
+		if !containsString(olds.Sources, source) {
+			detailedDiff[fmt.Sprintf("sources[%d]", i)] = p.PropertyDiff{Kind: p.Add}
+		}
+	}
+	for i, source := range olds.Sources {
+		if containsString(news.Sources, source) {
+			continue
+		}
+		path := fmt.Sprintf("sources[%d]", i)
+		if _, added := detailedDiff[path]; added {
+			detailedDiff[path] = p.PropertyDiff{Kind: p.Update}
+		} else {
+			detailedDiff[path] = p.PropertyDiff{Kind: p.Delete}
+		}
+	}
+
+	if olds.Target == news.Target {
+		config := infer.GetConfig[Config](ctx)
+		client, err := config.GetClient()
+		if err != nil {
+			return p.DiffResponse{}, err
+		}
+		plan, err := planUpstreamLineage(ctx, client, news)
+		if err != nil {
+			return p.DiffResponse{}, err
+		}
+		// Each edge is reported at its own path, so that the preview shows
+		// which upstream edges are removed.
+		for _, edge := range plan.stale {
+			detailedDiff[fmt.Sprintf("edges[%q]", upstreamEdgeKey(edge.Source, edge.JobMRN))] = p.PropertyDiff{Kind: p.Delete}
+		}
+		for _, source := range plan.missing {
+			path := fmt.Sprintf("edges[%q]", upstreamEdgeKey(source, news.JobMRN))
+			if _, stale := detailedDiff[path]; stale {
+				detailedDiff[path] = p.PropertyDiff{Kind: p.Update}
+			} else {
+				detailedDiff[path] = p.PropertyDiff{Kind: p.Add}
+			}
+		}
+	}
+
+	return p.DiffResponse{
+		HasChanges:          len(detailedDiff) > 0,
+		DetailedDiff:        detailedDiff,
+		DeleteBeforeReplace: true,
+	}, nil
+}
+
+func (UpstreamLineage) Create(ctx context.Context, name string, input UpstreamLineageArgs, preview bool) (string, UpstreamLineageState, error) {
+	state, err := applyUpstreamLineage(ctx, nil, input, preview)
+	return name, state, err
+}
+
+func (UpstreamLineage) Update(ctx context.Context, id string, olds UpstreamLineageState, news UpstreamLineageArgs, preview bool) (UpstreamLineageState, error) {
+	return applyUpstreamLineage(ctx, olds.Edges, news, preview)
+}
+
+// Delete removes the edges this resource created. Edges that already existed
+// when it adopted them, or that were created after the last update, are left
+// in place.
+func (UpstreamLineage) Delete(ctx context.Context, id string, state UpstreamLineageState) error {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(state.Edges) {
+		edge := state.Edges[key]
+		if !edge.Created {
+			continue
+		}
+		if err := deleteUpstreamEdge(ctx, client.Lineage, edge); err != nil {
+			errs = append(errs, fmt.Errorf("deleting edge %s -> %s: %w", edge.Source, state.Target, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyUpstreamLineage deletes the target's undeclared upstream edges and
+// creates the missing ones. In preview, it only reports the edges it would
+// remove. Edges kept from the previous state keep their created flag.
+func applyUpstreamLineage(ctx context.Context, olds map[string]UpstreamEdge, args UpstreamLineageArgs, preview bool) (UpstreamLineageState, error) {
+	state := UpstreamLineageState{UpstreamLineageArgs: args, Edges: map[string]UpstreamEdge{}}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return state, err
+	}
+
+	plan, err := planUpstreamLineage(ctx, client, args)
+	if err != nil {
+		return state, err
+	}
+	state.TargetID = plan.targetID
+	for _, edge := range plan.kept {
+		for _, old := range olds {
+			if old.ResourceID == edge.ResourceID {
+				edge.Created = old.Created
+			}
+		}
+		state.Edges[upstreamEdgeKey(edge.Source, edge.JobMRN)] = edge
+	}
+
+	if preview {
+		for _, edge := range plan.stale {
+			p.GetLogger(ctx).Warningf("upstream edge %s -> %s is not declared and will be removed", edge.Source, args.Target)
+		}
+		for _, source := range plan.missing {
+			state.Edges[upstreamEdgeKey(source, args.JobMRN)] = UpstreamEdge{Source: source, JobMRN: args.JobMRN, Created: true}
+		}
+		return state, nil
+	}
+
+	var reasons []string
+	for _, edge := range plan.stale {
+		if err := deleteUpstreamEdge(ctx, client.Lineage, edge); err != nil {
+			reasons = append(reasons, fmt.Sprintf("deleting edge %s -> %s: %s", edge.Source, args.Target, err))
+		}
+	}
+	for _, source := range plan.missing {
+		params := lineage.NewPostLineageDirectParamsWithContext(ctx).WithEdge(&models.LineageLineageEdge{
+			Source: source,
+			Target: args.Target,
+			JobMrn: args.JobMRN,
+		})
+		result, err := client.Lineage.PostLineageDirect(params)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("creating edge %s -> %s: %s", source, args.Target, err))
+			continue
+		}
+		edge := UpstreamEdge{Source: source, ResourceID: result.Payload.ID, JobMRN: result.Payload.JobMrn, Created: true}
+		state.Edges[upstreamEdgeKey(edge.Source, edge.JobMRN)] = edge
+	}
+
+	if len(reasons) > 0 {
+		return state, infer.ResourceInitFailedError{Reasons: reasons}
+	}
+	return state, nil
+}
+
+func deleteUpstreamEdge(ctx context.Context, client lineage.ClientService, edge UpstreamEdge) error {
+	if edge.ResourceID == "" {
+		return nil
+	}
+	params := lineage.NewDeleteLineageDirectIDParamsWithContext(ctx).WithID(strfmt.UUID(edge.ResourceID))
+	_, err := client.DeleteLineageDirectID(params)
+	return err
+}
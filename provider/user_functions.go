@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/client/users"
+	"github.com/marmotdata/pulumi-marmot/provider/internal/client/models"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type User struct {
+	ResourceID string     `pulumi:"resourceId"`
+	Username   string     `pulumi:"username"`
+	Name       string     `pulumi:"name"`
+	Active     bool       `pulumi:"active"`
+	Roles      []UserRole `pulumi:"roles"`
+	CreatedAt  string     `pulumi:"createdAt,optional"`
+	UpdatedAt  string     `pulumi:"updatedAt,optional"`
+}
+
+type UserRole struct {
+	ID          string   `pulumi:"id"`
+	Name        string   `pulumi:"name"`
+	Description string   `pulumi:"description,optional"`
+	Permissions []string `pulumi:"permissions"`
+}
+
+func parseToUser(user *models.UserUser) User {
+	result := User{
+		ResourceID: user.ID,
+		Username:   user.Username,
+		Name:       user.Name,
+		Active:     user.Active,
+		Roles:      []UserRole{},
+		CreatedAt:  user.CreatedAt,
+		UpdatedAt:  user.UpdatedAt,
+	}
+	for _, role := range user.Roles {
+		permissions := make([]string, 0, len(role.Permissions))
+		for _, permission := range role.Permissions {
+			permissions = append(permissions, permission.Name)
+		}
+		result.Roles = append(result.Roles, UserRole{
+			ID:          role.ID,
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		})
+	}
+	return result
+}
+
+type GetCurrentUser struct{}
+
+type GetCurrentUserArgs struct{}
+
+type GetCurrentUserResult struct {
+	User
+}
+
+func (GetCurrentUser) Call(ctx context.Context, args GetCurrentUserArgs) (GetCurrentUserResult, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return GetCurrentUserResult{}, err
+	}
+
+	result, err := client.Users.GetUsersMe(users.NewGetUsersMeParamsWithContext(ctx), nil)
+	if err != nil {
+		return GetCurrentUserResult{}, fmt.Errorf("reading current user: %w", err)
+	}
+	return GetCurrentUserResult{User: parseToUser(result.Payload)}, nil
+}
+
+type ListUsers struct{}
+
+type ListUsersArgs struct {
+	Query      *string  `pulumi:"query,optional"`
+	Active     *bool    `pulumi:"active,optional"`
+	RoleIDs    []string `pulumi:"roleIds,optional"`
+	Limit      *int     `pulumi:"limit,optional"`
+	Offset     *int     `pulumi:"offset,optional"`
+	MaxResults *int     `pulumi:"maxResults,optional"`
+}
+
+type ListUsersResult struct {
+	Users []User `pulumi:"users"`
+	Total int    `pulumi:"total"`
+}
+
+const (
+	defaultUserPageSize   = 50
+	defaultUserMaxResults = 1000
+)
+
+func (ListUsers) Call(ctx context.Context, args ListUsersArgs) (ListUsersResult, error) {
+	pageSize := intValue(args.Limit, defaultUserPageSize)
+	maxResults := intValue(args.MaxResults, defaultUserMaxResults)
+	if pageSize <= 0 || maxResults <= 0 {
+		return ListUsersResult{}, fmt.Errorf("limit and maxResults must be positive")
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.GetClient()
+	if err != nil {
+		return ListUsersResult{}, err
+	}
+
+	result := ListUsersResult{Users: []User{}}
+	offset := int64(intValue(args.Offset, 0))
+	for len(result.Users) < maxResults {
+		limit := int64(min(pageSize, maxResults-len(result.Users)))
+		params := users.NewGetUsersParamsWithContext(ctx).
+			WithQuery(args.Query).
+			WithActive(args.Active).
+			WithRoleIds(args.RoleIDs).
+			WithLimit(&limit).
+			WithOffset(&offset)
+		page, err := client.Users.GetUsers(params)
+		if err != nil {
+			return ListUsersResult{}, fmt.Errorf("listing users: %w", err)
+		}
+
+		result.Total = int(page.Payload.Total)
+		for _, user := range page.Payload.Users {
+			result.Users = append(result.Users, parseToUser(user))
+		}
+
+		offset += int64(len(page.Payload.Users))
+		if len(page.Payload.Users) == 0 || offset >= page.Payload.Total {
+			break
+		}
+	}
+
+	return result, nil
+}
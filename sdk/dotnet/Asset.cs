@@ -27,6 +27,9 @@ namespace Pulumi.Marmot
         [Output("externalLinks")]
         public Output<ImmutableArray<Outputs.ExternalLink>> ExternalLinks { get; private set; } = null!;
 
+        [Output("ignoreMetadataKeys")]
+        public Output<ImmutableArray<string>> IgnoreMetadataKeys { get; private set; } = null!;
+
         [Output("lastSyncAt")]
         public Output<string?> LastSyncAt { get; private set; } = null!;
 
@@ -45,6 +48,15 @@ namespace Pulumi.Marmot
         [Output("schema")]
         public Output<ImmutableDictionary<string, object>?> Schema { get; private set; } = null!;
 
+        [Output("schemaFile")]
+        public Output<string?> SchemaFile { get; private set; } = null!;
+
+        [Output("schemaFormat")]
+        public Output<string?> SchemaFormat { get; private set; } = null!;
+
+        [Output("schemaMessage")]
+        public Output<string?> SchemaMessage { get; private set; } = null!;
+
         [Output("services")]
         public Output<ImmutableArray<string>> Services { get; private set; } = null!;
 
@@ -124,6 +136,14 @@ namespace Pulumi.Marmot
             set => _externalLinks = value;
         }
 
+        [Input("ignoreMetadataKeys")]
+        private InputList<string>? _ignoreMetadataKeys;
+        public InputList<string> IgnoreMetadataKeys
+        {
+            get => _ignoreMetadataKeys ?? (_ignoreMetadataKeys = new InputList<string>());
+            set => _ignoreMetadataKeys = value;
+        }
+
         [Input("metadata")]
         private InputMap<object>? _metadata;
         public InputMap<object> Metadata
@@ -143,6 +163,15 @@ namespace Pulumi.Marmot
             set => _schema = value;
         }
 
+        [Input("schemaFile")]
+        public Input<string>? SchemaFile { get; set; }
+
+        [Input("schemaFormat")]
+        public Input<string>? SchemaFormat { get; set; }
+
+        [Input("schemaMessage")]
+        public Input<string>? SchemaMessage { get; set; }
+
         [Input("services", required: true)]
         private InputList<string>? _services;
         public InputList<string> Services
@@ -0,0 +1,93 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    [MarmotResourceType("marmot:index:AssetCollection")]
+    public partial class AssetCollection : global::Pulumi.CustomResource
+    {
+        [Output("assets")]
+        public Output<ImmutableDictionary<string, Outputs.AssetCollectionItem>> Assets { get; private set; } = null!;
+
+        [Output("config")]
+        public Output<ImmutableDictionary<string, object>?> Config { get; private set; } = null!;
+
+        [Output("mrns")]
+        public Output<ImmutableDictionary<string, string>> Mrns { get; private set; } = null!;
+
+        [Output("resourceIds")]
+        public Output<ImmutableDictionary<string, string>> ResourceIds { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AssetCollection resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AssetCollection(string name, AssetCollectionArgs args, CustomResourceOptions? options = null)
+            : base("marmot:index:AssetCollection", name, args ?? new AssetCollectionArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private AssetCollection(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("marmot:index:AssetCollection", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing AssetCollection resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static AssetCollection Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new AssetCollection(name, id, options);
+        }
+    }
+
+    public sealed class AssetCollectionArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("assets", required: true)]
+        private InputMap<Inputs.AssetCollectionItemArgs>? _assets;
+        public InputMap<Inputs.AssetCollectionItemArgs> Assets
+        {
+            get => _assets ?? (_assets = new InputMap<Inputs.AssetCollectionItemArgs>());
+            set => _assets = value;
+        }
+
+        [Input("config")]
+        private InputMap<object>? _config;
+        public InputMap<object> Config
+        {
+            get => _config ?? (_config = new InputMap<object>());
+            set => _config = value;
+        }
+
+        public AssetCollectionArgs()
+        {
+        }
+        public static new AssetCollectionArgs Empty => new AssetCollectionArgs();
+    }
+}
@@ -32,6 +32,13 @@ namespace Pulumi.Marmot
 
         private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("marmot");
 
+        private static readonly __Value<ImmutableArray<string>> _allowedTags = new __Value<ImmutableArray<string>>(() => __config.GetObject<ImmutableArray<string>>("allowedTags"));
+        public static ImmutableArray<string> AllowedTags
+        {
+            get => _allowedTags.Get();
+            set => _allowedTags.Set(value);
+        }
+
         private static readonly __Value<string?> _apiKey = new __Value<string?>(() => __config.Get("apiKey"));
         public static string? ApiKey
         {
@@ -39,6 +46,48 @@ namespace Pulumi.Marmot
             set => _apiKey.Set(value);
         }
 
+        private static readonly __Value<bool?> _batchCreates = new __Value<bool?>(() => __config.GetBoolean("batchCreates"));
+        public static bool? BatchCreates
+        {
+            get => _batchCreates.Get();
+            set => _batchCreates.Set(value);
+        }
+
+        private static readonly __Value<int?> _batchSize = new __Value<int?>(() => __config.GetInt32("batchSize"));
+        public static int? BatchSize
+        {
+            get => _batchSize.Get();
+            set => _batchSize.Set(value);
+        }
+
+        private static readonly __Value<int?> _batchWindowMs = new __Value<int?>(() => __config.GetInt32("batchWindowMs"));
+        public static int? BatchWindowMs
+        {
+            get => _batchWindowMs.Get();
+            set => _batchWindowMs.Set(value);
+        }
+
+        private static readonly __Value<ImmutableDictionary<string, object>?> _defaultMetadata = new __Value<ImmutableDictionary<string, object>?>(() => __config.GetObject<ImmutableDictionary<string, object>>("defaultMetadata"));
+        public static ImmutableDictionary<string, object>? DefaultMetadata
+        {
+            get => _defaultMetadata.Get();
+            set => _defaultMetadata.Set(value);
+        }
+
+        private static readonly __Value<ImmutableArray<string>> _defaultTags = new __Value<ImmutableArray<string>>(() => __config.GetObject<ImmutableArray<string>>("defaultTags"));
+        public static ImmutableArray<string> DefaultTags
+        {
+            get => _defaultTags.Get();
+            set => _defaultTags.Set(value);
+        }
+
+        private static readonly __Value<bool?> _failFast = new __Value<bool?>(() => __config.GetBoolean("failFast"));
+        public static bool? FailFast
+        {
+            get => _failFast.Get();
+            set => _failFast.Set(value);
+        }
+
         private static readonly __Value<string?> _host = new __Value<string?>(() => __config.Get("host"));
         public static string? Host
         {
@@ -46,5 +95,26 @@ namespace Pulumi.Marmot
             set => _host.Set(value);
         }
 
+        private static readonly __Value<ImmutableArray<string>> _ignoreMetadataKeys = new __Value<ImmutableArray<string>>(() => __config.GetObject<ImmutableArray<string>>("ignoreMetadataKeys"));
+        public static ImmutableArray<string> IgnoreMetadataKeys
+        {
+            get => _ignoreMetadataKeys.Get();
+            set => _ignoreMetadataKeys.Set(value);
+        }
+
+        private static readonly __Value<bool?> _provenance = new __Value<bool?>(() => __config.GetBoolean("provenance"));
+        public static bool? Provenance
+        {
+            get => _provenance.Get();
+            set => _provenance.Set(value);
+        }
+
+        private static readonly __Value<string?> _strictTags = new __Value<string?>(() => __config.Get("strictTags"));
+        public static string? StrictTags
+        {
+            get => _strictTags.Get();
+            set => _strictTags.Set(value);
+        }
+
     }
 }
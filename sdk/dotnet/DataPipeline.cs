@@ -0,0 +1,98 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    [MarmotResourceType("marmot:index:DataPipeline")]
+    public partial class DataPipeline : global::Pulumi.ComponentResource
+    {
+        [Output("edges")]
+        public Output<ImmutableArray<ImmutableDictionary<string, string>>> Edges { get; private set; } = null!;
+
+        [Output("inputMrns")]
+        public Output<ImmutableArray<string>> InputMrns { get; private set; } = null!;
+
+        [Output("jobMrn")]
+        public Output<string> JobMrn { get; private set; } = null!;
+
+        [Output("outputMrns")]
+        public Output<ImmutableArray<string>> OutputMrns { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a DataPipeline resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public DataPipeline(string name, DataPipelineArgs? args = null, ComponentResourceOptions? options = null)
+            : base("marmot:index:DataPipeline", name, args ?? new DataPipelineArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class DataPipelineArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("inputMrns")]
+        private InputList<string>? _inputMrns;
+        public InputList<string> InputMrns
+        {
+            get => _inputMrns ?? (_inputMrns = new InputList<string>());
+            set => _inputMrns = value;
+        }
+
+        [Input("inputs")]
+        private InputList<Inputs.AssetCollectionItemArgs>? _inputs;
+        public InputList<Inputs.AssetCollectionItemArgs> Inputs
+        {
+            get => _inputs ?? (_inputs = new InputList<Inputs.AssetCollectionItemArgs>());
+            set => _inputs = value;
+        }
+
+        [Input("job")]
+        public Input<Inputs.AssetCollectionItemArgs>? Job { get; set; }
+
+        [Input("jobMrn")]
+        public Input<string>? JobMrn { get; set; }
+
+        [Input("outputMrns")]
+        private InputList<string>? _outputMrns;
+        public InputList<string> OutputMrns
+        {
+            get => _outputMrns ?? (_outputMrns = new InputList<string>());
+            set => _outputMrns = value;
+        }
+
+        [Input("outputs")]
+        private InputList<Inputs.AssetCollectionItemArgs>? _outputs;
+        public InputList<Inputs.AssetCollectionItemArgs> Outputs
+        {
+            get => _outputs ?? (_outputs = new InputList<Inputs.AssetCollectionItemArgs>());
+            set => _outputs = value;
+        }
+
+        public DataPipelineArgs()
+        {
+        }
+        public static new DataPipelineArgs Empty => new DataPipelineArgs();
+    }
+}
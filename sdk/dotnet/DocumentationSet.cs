@@ -0,0 +1,97 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    [MarmotResourceType("marmot:index:DocumentationSet")]
+    public partial class DocumentationSet : global::Pulumi.CustomResource
+    {
+        [Output("directory")]
+        public Output<string> Directory { get; private set; } = null!;
+
+        [Output("documents")]
+        public Output<ImmutableDictionary<string, Outputs.DocumentResult>> Documents { get; private set; } = null!;
+
+        [Output("globalDocs")]
+        public Output<ImmutableDictionary<string, ImmutableArray<string>>?> GlobalDocs { get; private set; } = null!;
+
+        [Output("mrnTemplate")]
+        public Output<string?> MrnTemplate { get; private set; } = null!;
+
+        [Output("source")]
+        public Output<string?> Source { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a DocumentationSet resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public DocumentationSet(string name, DocumentationSetArgs args, CustomResourceOptions? options = null)
+            : base("marmot:index:DocumentationSet", name, args ?? new DocumentationSetArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private DocumentationSet(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("marmot:index:DocumentationSet", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing DocumentationSet resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static DocumentationSet Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new DocumentationSet(name, id, options);
+        }
+    }
+
+    public sealed class DocumentationSetArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("directory", required: true)]
+        public Input<string> Directory { get; set; } = null!;
+
+        [Input("globalDocs")]
+        private InputMap<ImmutableArray<string>>? _globalDocs;
+        public InputMap<ImmutableArray<string>> GlobalDocs
+        {
+            get => _globalDocs ?? (_globalDocs = new InputMap<ImmutableArray<string>>());
+            set => _globalDocs = value;
+        }
+
+        [Input("mrnTemplate")]
+        public Input<string>? MrnTemplate { get; set; }
+
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
+        public DocumentationSetArgs()
+        {
+        }
+        public static new DocumentationSetArgs Empty => new DocumentationSetArgs();
+    }
+}
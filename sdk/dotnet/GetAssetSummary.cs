@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetAssetSummary
+    {
+        public static Task<GetAssetSummaryResult> InvokeAsync(GetAssetSummaryArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetAssetSummaryResult>("marmot:index:getAssetSummary", args ?? new GetAssetSummaryArgs(), options.WithDefaults());
+
+        public static Output<GetAssetSummaryResult> Invoke(GetAssetSummaryInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAssetSummaryResult>("marmot:index:getAssetSummary", args ?? new GetAssetSummaryInvokeArgs(), options.WithDefaults());
+
+        public static Output<GetAssetSummaryResult> Invoke(GetAssetSummaryInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAssetSummaryResult>("marmot:index:getAssetSummary", args ?? new GetAssetSummaryInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetAssetSummaryArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("minServiceCounts")]
+        private Dictionary<string, int>? _minServiceCounts;
+        public Dictionary<string, int> MinServiceCounts
+        {
+            get => _minServiceCounts ?? (_minServiceCounts = new Dictionary<string, int>());
+            set => _minServiceCounts = value;
+        }
+
+        [Input("minTagCounts")]
+        private Dictionary<string, int>? _minTagCounts;
+        public Dictionary<string, int> MinTagCounts
+        {
+            get => _minTagCounts ?? (_minTagCounts = new Dictionary<string, int>());
+            set => _minTagCounts = value;
+        }
+
+        [Input("minTypeCounts")]
+        private Dictionary<string, int>? _minTypeCounts;
+        public Dictionary<string, int> MinTypeCounts
+        {
+            get => _minTypeCounts ?? (_minTypeCounts = new Dictionary<string, int>());
+            set => _minTypeCounts = value;
+        }
+
+        public GetAssetSummaryArgs()
+        {
+        }
+        public static new GetAssetSummaryArgs Empty => new GetAssetSummaryArgs();
+    }
+
+    public sealed class GetAssetSummaryInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("minServiceCounts")]
+        private InputMap<int>? _minServiceCounts;
+        public InputMap<int> MinServiceCounts
+        {
+            get => _minServiceCounts ?? (_minServiceCounts = new InputMap<int>());
+            set => _minServiceCounts = value;
+        }
+
+        [Input("minTagCounts")]
+        private InputMap<int>? _minTagCounts;
+        public InputMap<int> MinTagCounts
+        {
+            get => _minTagCounts ?? (_minTagCounts = new InputMap<int>());
+            set => _minTagCounts = value;
+        }
+
+        [Input("minTypeCounts")]
+        private InputMap<int>? _minTypeCounts;
+        public InputMap<int> MinTypeCounts
+        {
+            get => _minTypeCounts ?? (_minTypeCounts = new InputMap<int>());
+            set => _minTypeCounts = value;
+        }
+
+        public GetAssetSummaryInvokeArgs()
+        {
+        }
+        public static new GetAssetSummaryInvokeArgs Empty => new GetAssetSummaryInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetAssetSummaryResult
+    {
+        public readonly ImmutableDictionary<string, int> Services;
+        public readonly ImmutableDictionary<string, int> Tags;
+        public readonly int Total;
+        public readonly ImmutableDictionary<string, int> Types;
+
+        [OutputConstructor]
+        private GetAssetSummaryResult(
+            ImmutableDictionary<string, int> services,
+
+            ImmutableDictionary<string, int> tags,
+
+            int total,
+
+            ImmutableDictionary<string, int> types)
+        {
+            Services = services;
+            Tags = tags;
+            Total = total;
+            Types = types;
+        }
+    }
+}
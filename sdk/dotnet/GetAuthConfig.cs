@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetAuthConfig
+    {
+        public static Task<GetAuthConfigResult> InvokeAsync(GetAuthConfigArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetAuthConfigResult>("marmot:index:getAuthConfig", args ?? new GetAuthConfigArgs(), options.WithDefaults());
+
+        public static Output<GetAuthConfigResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAuthConfigResult>("marmot:index:getAuthConfig", InvokeArgs.Empty, options.WithDefaults());
+
+        public static Output<GetAuthConfigResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetAuthConfigResult>("marmot:index:getAuthConfig", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetAuthConfigArgs : global::Pulumi.InvokeArgs
+    {
+        public GetAuthConfigArgs()
+        {
+        }
+        public static new GetAuthConfigArgs Empty => new GetAuthConfigArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetAuthConfigResult
+    {
+        public readonly ImmutableArray<string> EnabledProviders;
+
+        [OutputConstructor]
+        private GetAuthConfigResult(ImmutableArray<string> enabledProviders)
+        {
+            EnabledProviders = enabledProviders;
+        }
+    }
+}
@@ -0,0 +1,130 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetCatalogAsset
+    {
+        public static Task<GetCatalogAssetResult> InvokeAsync(GetCatalogAssetArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetCatalogAssetResult>("marmot:index:getCatalogAsset", args ?? new GetCatalogAssetArgs(), options.WithDefaults());
+
+        public static Output<GetCatalogAssetResult> Invoke(GetCatalogAssetInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCatalogAssetResult>("marmot:index:getCatalogAsset", args ?? new GetCatalogAssetInvokeArgs(), options.WithDefaults());
+
+        public static Output<GetCatalogAssetResult> Invoke(GetCatalogAssetInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCatalogAssetResult>("marmot:index:getCatalogAsset", args ?? new GetCatalogAssetInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetCatalogAssetArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("mrn")]
+        public string? Mrn { get; set; }
+
+        [Input("resourceId")]
+        public string? ResourceId { get; set; }
+
+        public GetCatalogAssetArgs()
+        {
+        }
+        public static new GetCatalogAssetArgs Empty => new GetCatalogAssetArgs();
+    }
+
+    public sealed class GetCatalogAssetInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("mrn")]
+        public Input<string>? Mrn { get; set; }
+
+        [Input("resourceId")]
+        public Input<string>? ResourceId { get; set; }
+
+        public GetCatalogAssetInvokeArgs()
+        {
+        }
+        public static new GetCatalogAssetInvokeArgs Empty => new GetCatalogAssetInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetCatalogAssetResult
+    {
+        public readonly string CreatedAt;
+        public readonly string CreatedBy;
+        public readonly string? Description;
+        public readonly ImmutableDictionary<string, Outputs.AssetEnvironment>? Environments;
+        public readonly ImmutableArray<Outputs.ExternalLink> ExternalLinks;
+        public readonly string? LastSyncAt;
+        public readonly ImmutableDictionary<string, object>? Metadata;
+        public readonly string Mrn;
+        public readonly string Name;
+        public readonly string? ParentMrn;
+        public readonly string ResourceId;
+        public readonly ImmutableDictionary<string, object>? Schema;
+        public readonly ImmutableArray<string> Services;
+        public readonly ImmutableArray<Outputs.AssetSource> Sources;
+        public readonly ImmutableArray<string> Tags;
+        public readonly string Type;
+        public readonly string UpdatedAt;
+
+        [OutputConstructor]
+        private GetCatalogAssetResult(
+            string createdAt,
+
+            string createdBy,
+
+            string? description,
+
+            ImmutableDictionary<string, Outputs.AssetEnvironment>? environments,
+
+            ImmutableArray<Outputs.ExternalLink> externalLinks,
+
+            string? lastSyncAt,
+
+            ImmutableDictionary<string, object>? metadata,
+
+            string mrn,
+
+            string name,
+
+            string? parentMrn,
+
+            string resourceId,
+
+            ImmutableDictionary<string, object>? schema,
+
+            ImmutableArray<string> services,
+
+            ImmutableArray<Outputs.AssetSource> sources,
+
+            ImmutableArray<string> tags,
+
+            string type,
+
+            string updatedAt)
+        {
+            CreatedAt = createdAt;
+            CreatedBy = createdBy;
+            Description = description;
+            Environments = environments;
+            ExternalLinks = externalLinks;
+            LastSyncAt = lastSyncAt;
+            Metadata = metadata;
+            Mrn = mrn;
+            Name = name;
+            ParentMrn = parentMrn;
+            ResourceId = resourceId;
+            Schema = schema;
+            Services = services;
+            Sources = sources;
+            Tags = tags;
+            Type = type;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
@@ -0,0 +1,70 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetCurrentUser
+    {
+        public static Task<GetCurrentUserResult> InvokeAsync(GetCurrentUserArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetCurrentUserResult>("marmot:index:getCurrentUser", args ?? new GetCurrentUserArgs(), options.WithDefaults());
+
+        public static Output<GetCurrentUserResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCurrentUserResult>("marmot:index:getCurrentUser", InvokeArgs.Empty, options.WithDefaults());
+
+        public static Output<GetCurrentUserResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetCurrentUserResult>("marmot:index:getCurrentUser", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetCurrentUserArgs : global::Pulumi.InvokeArgs
+    {
+        public GetCurrentUserArgs()
+        {
+        }
+        public static new GetCurrentUserArgs Empty => new GetCurrentUserArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetCurrentUserResult
+    {
+        public readonly bool Active;
+        public readonly string? CreatedAt;
+        public readonly string Name;
+        public readonly string ResourceId;
+        public readonly ImmutableArray<Outputs.UserRole> Roles;
+        public readonly string? UpdatedAt;
+        public readonly string Username;
+
+        [OutputConstructor]
+        private GetCurrentUserResult(
+            bool active,
+
+            string? createdAt,
+
+            string name,
+
+            string resourceId,
+
+            ImmutableArray<Outputs.UserRole> roles,
+
+            string? updatedAt,
+
+            string username)
+        {
+            Active = active;
+            CreatedAt = createdAt;
+            Name = name;
+            ResourceId = resourceId;
+            Roles = roles;
+            UpdatedAt = updatedAt;
+            Username = username;
+        }
+    }
+}
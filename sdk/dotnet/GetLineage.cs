@@ -0,0 +1,76 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetLineage
+    {
+        public static Task<GetLineageResult> InvokeAsync(GetLineageArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetLineageResult>("marmot:index:getLineage", args ?? new GetLineageArgs(), options.WithDefaults());
+
+        public static Output<GetLineageResult> Invoke(GetLineageInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetLineageResult>("marmot:index:getLineage", args ?? new GetLineageInvokeArgs(), options.WithDefaults());
+
+        public static Output<GetLineageResult> Invoke(GetLineageInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetLineageResult>("marmot:index:getLineage", args ?? new GetLineageInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetLineageArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("depth")]
+        public int? Depth { get; set; }
+
+        [Input("direction")]
+        public string? Direction { get; set; }
+
+        [Input("resourceId", required: true)]
+        public string ResourceId { get; set; } = null!;
+
+        public GetLineageArgs()
+        {
+        }
+        public static new GetLineageArgs Empty => new GetLineageArgs();
+    }
+
+    public sealed class GetLineageInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("depth")]
+        public Input<int>? Depth { get; set; }
+
+        [Input("direction")]
+        public Input<string>? Direction { get; set; }
+
+        [Input("resourceId", required: true)]
+        public Input<string> ResourceId { get; set; } = null!;
+
+        public GetLineageInvokeArgs()
+        {
+        }
+        public static new GetLineageInvokeArgs Empty => new GetLineageInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetLineageResult
+    {
+        public readonly ImmutableArray<Outputs.LineageEdge> Edges;
+        public readonly ImmutableArray<Outputs.LineageNode> Nodes;
+
+        [OutputConstructor]
+        private GetLineageResult(
+            ImmutableArray<Outputs.LineageEdge> edges,
+
+            ImmutableArray<Outputs.LineageNode> nodes)
+        {
+            Edges = edges;
+            Nodes = nodes;
+        }
+    }
+}
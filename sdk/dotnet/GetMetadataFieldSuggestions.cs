@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetMetadataFieldSuggestions
+    {
+        public static Task<GetMetadataFieldSuggestionsResult> InvokeAsync(GetMetadataFieldSuggestionsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetMetadataFieldSuggestionsResult>("marmot:index:getMetadataFieldSuggestions", args ?? new GetMetadataFieldSuggestionsArgs(), options.WithDefaults());
+
+        public static Output<GetMetadataFieldSuggestionsResult> Invoke(GetMetadataFieldSuggestionsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMetadataFieldSuggestionsResult>("marmot:index:getMetadataFieldSuggestions", args ?? new GetMetadataFieldSuggestionsInvokeArgs(), options.WithDefaults());
+
+        public static Output<GetMetadataFieldSuggestionsResult> Invoke(GetMetadataFieldSuggestionsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMetadataFieldSuggestionsResult>("marmot:index:getMetadataFieldSuggestions", args ?? new GetMetadataFieldSuggestionsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetMetadataFieldSuggestionsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("limit")]
+        public int? Limit { get; set; }
+
+        [Input("prefix")]
+        public string? Prefix { get; set; }
+
+        public GetMetadataFieldSuggestionsArgs()
+        {
+        }
+        public static new GetMetadataFieldSuggestionsArgs Empty => new GetMetadataFieldSuggestionsArgs();
+    }
+
+    public sealed class GetMetadataFieldSuggestionsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("limit")]
+        public Input<int>? Limit { get; set; }
+
+        [Input("prefix")]
+        public Input<string>? Prefix { get; set; }
+
+        public GetMetadataFieldSuggestionsInvokeArgs()
+        {
+        }
+        public static new GetMetadataFieldSuggestionsInvokeArgs Empty => new GetMetadataFieldSuggestionsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetMetadataFieldSuggestionsResult
+    {
+        public readonly ImmutableArray<Outputs.MetadataFieldSuggestion> Fields;
+
+        [OutputConstructor]
+        private GetMetadataFieldSuggestionsResult(ImmutableArray<Outputs.MetadataFieldSuggestion> fields)
+        {
+            Fields = fields;
+        }
+    }
+}
@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetMetadataValueSuggestions
+    {
+        public static Task<GetMetadataValueSuggestionsResult> InvokeAsync(GetMetadataValueSuggestionsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetMetadataValueSuggestionsResult>("marmot:index:getMetadataValueSuggestions", args ?? new GetMetadataValueSuggestionsArgs(), options.WithDefaults());
+
+        public static Output<GetMetadataValueSuggestionsResult> Invoke(GetMetadataValueSuggestionsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMetadataValueSuggestionsResult>("marmot:index:getMetadataValueSuggestions", args ?? new GetMetadataValueSuggestionsInvokeArgs(), options.WithDefaults());
+
+        public static Output<GetMetadataValueSuggestionsResult> Invoke(GetMetadataValueSuggestionsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetMetadataValueSuggestionsResult>("marmot:index:getMetadataValueSuggestions", args ?? new GetMetadataValueSuggestionsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetMetadataValueSuggestionsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("field", required: true)]
+        public string Field { get; set; } = null!;
+
+        [Input("limit")]
+        public int? Limit { get; set; }
+
+        [Input("prefix")]
+        public string? Prefix { get; set; }
+
+        public GetMetadataValueSuggestionsArgs()
+        {
+        }
+        public static new GetMetadataValueSuggestionsArgs Empty => new GetMetadataValueSuggestionsArgs();
+    }
+
+    public sealed class GetMetadataValueSuggestionsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("field", required: true)]
+        public Input<string> Field { get; set; } = null!;
+
+        [Input("limit")]
+        public Input<int>? Limit { get; set; }
+
+        [Input("prefix")]
+        public Input<string>? Prefix { get; set; }
+
+        public GetMetadataValueSuggestionsInvokeArgs()
+        {
+        }
+        public static new GetMetadataValueSuggestionsInvokeArgs Empty => new GetMetadataValueSuggestionsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetMetadataValueSuggestionsResult
+    {
+        public readonly ImmutableArray<Outputs.MetadataValueSuggestion> Values;
+
+        [OutputConstructor]
+        private GetMetadataValueSuggestionsResult(ImmutableArray<Outputs.MetadataValueSuggestion> values)
+        {
+            Values = values;
+        }
+    }
+}
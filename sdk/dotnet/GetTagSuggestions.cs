@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class GetTagSuggestions
+    {
+        public static Task<GetTagSuggestionsResult> InvokeAsync(GetTagSuggestionsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetTagSuggestionsResult>("marmot:index:getTagSuggestions", args ?? new GetTagSuggestionsArgs(), options.WithDefaults());
+
+        public static Output<GetTagSuggestionsResult> Invoke(GetTagSuggestionsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetTagSuggestionsResult>("marmot:index:getTagSuggestions", args ?? new GetTagSuggestionsInvokeArgs(), options.WithDefaults());
+
+        public static Output<GetTagSuggestionsResult> Invoke(GetTagSuggestionsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetTagSuggestionsResult>("marmot:index:getTagSuggestions", args ?? new GetTagSuggestionsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetTagSuggestionsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("limit")]
+        public int? Limit { get; set; }
+
+        [Input("prefix")]
+        public string? Prefix { get; set; }
+
+        public GetTagSuggestionsArgs()
+        {
+        }
+        public static new GetTagSuggestionsArgs Empty => new GetTagSuggestionsArgs();
+    }
+
+    public sealed class GetTagSuggestionsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("limit")]
+        public Input<int>? Limit { get; set; }
+
+        [Input("prefix")]
+        public Input<string>? Prefix { get; set; }
+
+        public GetTagSuggestionsInvokeArgs()
+        {
+        }
+        public static new GetTagSuggestionsInvokeArgs Empty => new GetTagSuggestionsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetTagSuggestionsResult
+    {
+        public readonly ImmutableArray<string> Tags;
+
+        [OutputConstructor]
+        private GetTagSuggestionsResult(ImmutableArray<string> tags)
+        {
+            Tags = tags;
+        }
+    }
+}
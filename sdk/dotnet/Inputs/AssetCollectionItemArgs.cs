@@ -0,0 +1,93 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Inputs
+{
+
+    public sealed class AssetCollectionItemArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        [Input("environments")]
+        private InputMap<Inputs.AssetEnvironmentArgs>? _environments;
+        public InputMap<Inputs.AssetEnvironmentArgs> Environments
+        {
+            get => _environments ?? (_environments = new InputMap<Inputs.AssetEnvironmentArgs>());
+            set => _environments = value;
+        }
+
+        [Input("externalLinks")]
+        private InputList<Inputs.ExternalLinkArgs>? _externalLinks;
+        public InputList<Inputs.ExternalLinkArgs> ExternalLinks
+        {
+            get => _externalLinks ?? (_externalLinks = new InputList<Inputs.ExternalLinkArgs>());
+            set => _externalLinks = value;
+        }
+
+        [Input("ignoreMetadataKeys")]
+        private InputList<string>? _ignoreMetadataKeys;
+        public InputList<string> IgnoreMetadataKeys
+        {
+            get => _ignoreMetadataKeys ?? (_ignoreMetadataKeys = new InputList<string>());
+            set => _ignoreMetadataKeys = value;
+        }
+
+        [Input("metadata")]
+        private InputMap<object>? _metadata;
+        public InputMap<object> Metadata
+        {
+            get => _metadata ?? (_metadata = new InputMap<object>());
+            set => _metadata = value;
+        }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        [Input("schema")]
+        private InputMap<object>? _schema;
+        public InputMap<object> Schema
+        {
+            get => _schema ?? (_schema = new InputMap<object>());
+            set => _schema = value;
+        }
+
+        [Input("services", required: true)]
+        private InputList<string>? _services;
+        public InputList<string> Services
+        {
+            get => _services ?? (_services = new InputList<string>());
+            set => _services = value;
+        }
+
+        [Input("sources")]
+        private InputList<Inputs.AssetSourceArgs>? _sources;
+        public InputList<Inputs.AssetSourceArgs> Sources
+        {
+            get => _sources ?? (_sources = new InputList<Inputs.AssetSourceArgs>());
+            set => _sources = value;
+        }
+
+        [Input("tags")]
+        private InputList<string>? _tags;
+        public InputList<string> Tags
+        {
+            get => _tags ?? (_tags = new InputList<string>());
+            set => _tags = value;
+        }
+
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public AssetCollectionItemArgs()
+        {
+        }
+        public static new AssetCollectionItemArgs Empty => new AssetCollectionItemArgs();
+    }
+}
@@ -0,0 +1,29 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Inputs
+{
+
+    public sealed class LineageSetEdgeArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("jobMrn")]
+        public Input<string>? JobMrn { get; set; }
+
+        [Input("source", required: true)]
+        public Input<string> Source { get; set; } = null!;
+
+        [Input("target", required: true)]
+        public Input<string> Target { get; set; } = null!;
+
+        public LineageSetEdgeArgs()
+        {
+        }
+        public static new LineageSetEdgeArgs Empty => new LineageSetEdgeArgs();
+    }
+}
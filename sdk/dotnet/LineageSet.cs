@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    [MarmotResourceType("marmot:index:LineageSet")]
+    public partial class LineageSet : global::Pulumi.CustomResource
+    {
+        [Output("edges")]
+        public Output<ImmutableArray<Outputs.LineageSetEdge>> Edges { get; private set; } = null!;
+
+        [Output("results")]
+        public Output<ImmutableArray<Outputs.LineageSetEdgeResult>> Results { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a LineageSet resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public LineageSet(string name, LineageSetArgs args, CustomResourceOptions? options = null)
+            : base("marmot:index:LineageSet", name, args ?? new LineageSetArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private LineageSet(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("marmot:index:LineageSet", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing LineageSet resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static LineageSet Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new LineageSet(name, id, options);
+        }
+    }
+
+    public sealed class LineageSetArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("edges", required: true)]
+        private InputList<Inputs.LineageSetEdgeArgs>? _edges;
+        public InputList<Inputs.LineageSetEdgeArgs> Edges
+        {
+            get => _edges ?? (_edges = new InputList<Inputs.LineageSetEdgeArgs>());
+            set => _edges = value;
+        }
+
+        public LineageSetArgs()
+        {
+        }
+        public static new LineageSetArgs Empty => new LineageSetArgs();
+    }
+}
@@ -0,0 +1,74 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class ListAssets
+    {
+        public static Task<ListAssetsResult> InvokeAsync(ListAssetsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ListAssetsResult>("marmot:index:listAssets", args ?? new ListAssetsArgs(), options.WithDefaults());
+
+        public static Output<ListAssetsResult> Invoke(ListAssetsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ListAssetsResult>("marmot:index:listAssets", args ?? new ListAssetsInvokeArgs(), options.WithDefaults());
+
+        public static Output<ListAssetsResult> Invoke(ListAssetsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ListAssetsResult>("marmot:index:listAssets", args ?? new ListAssetsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ListAssetsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("pageSize")]
+        public int? PageSize { get; set; }
+
+        [Input("parallelism")]
+        public int? Parallelism { get; set; }
+
+        public ListAssetsArgs()
+        {
+        }
+        public static new ListAssetsArgs Empty => new ListAssetsArgs();
+    }
+
+    public sealed class ListAssetsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("pageSize")]
+        public Input<int>? PageSize { get; set; }
+
+        [Input("parallelism")]
+        public Input<int>? Parallelism { get; set; }
+
+        public ListAssetsInvokeArgs()
+        {
+        }
+        public static new ListAssetsInvokeArgs Empty => new ListAssetsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ListAssetsResult
+    {
+        public readonly ImmutableArray<Outputs.AssetSummary> Assets;
+        public readonly int Count;
+        public readonly int Total;
+
+        [OutputConstructor]
+        private ListAssetsResult(
+            ImmutableArray<Outputs.AssetSummary> assets,
+
+            int count,
+
+            int total)
+        {
+            Assets = assets;
+            Count = count;
+            Total = total;
+        }
+    }
+}
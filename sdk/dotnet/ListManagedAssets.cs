@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class ListManagedAssets
+    {
+        public static Task<ListManagedAssetsResult> InvokeAsync(ListManagedAssetsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ListManagedAssetsResult>("marmot:index:listManagedAssets", args ?? new ListManagedAssetsArgs(), options.WithDefaults());
+
+        public static Output<ListManagedAssetsResult> Invoke(ListManagedAssetsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ListManagedAssetsResult>("marmot:index:listManagedAssets", args ?? new ListManagedAssetsInvokeArgs(), options.WithDefaults());
+
+        public static Output<ListManagedAssetsResult> Invoke(ListManagedAssetsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ListManagedAssetsResult>("marmot:index:listManagedAssets", args ?? new ListManagedAssetsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ListManagedAssetsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("project")]
+        public string? Project { get; set; }
+
+        [Input("stack", required: true)]
+        public string Stack { get; set; } = null!;
+
+        public ListManagedAssetsArgs()
+        {
+        }
+        public static new ListManagedAssetsArgs Empty => new ListManagedAssetsArgs();
+    }
+
+    public sealed class ListManagedAssetsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("project")]
+        public Input<string>? Project { get; set; }
+
+        [Input("stack", required: true)]
+        public Input<string> Stack { get; set; } = null!;
+
+        public ListManagedAssetsInvokeArgs()
+        {
+        }
+        public static new ListManagedAssetsInvokeArgs Empty => new ListManagedAssetsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ListManagedAssetsResult
+    {
+        public readonly ImmutableArray<Outputs.ManagedAsset> Assets;
+
+        [OutputConstructor]
+        private ListManagedAssetsResult(ImmutableArray<Outputs.ManagedAsset> assets)
+        {
+            Assets = assets;
+        }
+    }
+}
@@ -0,0 +1,104 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class ListUsers
+    {
+        public static Task<ListUsersResult> InvokeAsync(ListUsersArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ListUsersResult>("marmot:index:listUsers", args ?? new ListUsersArgs(), options.WithDefaults());
+
+        public static Output<ListUsersResult> Invoke(ListUsersInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ListUsersResult>("marmot:index:listUsers", args ?? new ListUsersInvokeArgs(), options.WithDefaults());
+
+        public static Output<ListUsersResult> Invoke(ListUsersInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ListUsersResult>("marmot:index:listUsers", args ?? new ListUsersInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ListUsersArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("active")]
+        public bool? Active { get; set; }
+
+        [Input("limit")]
+        public int? Limit { get; set; }
+
+        [Input("maxResults")]
+        public int? MaxResults { get; set; }
+
+        [Input("offset")]
+        public int? Offset { get; set; }
+
+        [Input("query")]
+        public string? Query { get; set; }
+
+        [Input("roleIds")]
+        private List<string>? _roleIds;
+        public List<string> RoleIds
+        {
+            get => _roleIds ?? (_roleIds = new List<string>());
+            set => _roleIds = value;
+        }
+
+        public ListUsersArgs()
+        {
+        }
+        public static new ListUsersArgs Empty => new ListUsersArgs();
+    }
+
+    public sealed class ListUsersInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("active")]
+        public Input<bool>? Active { get; set; }
+
+        [Input("limit")]
+        public Input<int>? Limit { get; set; }
+
+        [Input("maxResults")]
+        public Input<int>? MaxResults { get; set; }
+
+        [Input("offset")]
+        public Input<int>? Offset { get; set; }
+
+        [Input("query")]
+        public Input<string>? Query { get; set; }
+
+        [Input("roleIds")]
+        private InputList<string>? _roleIds;
+        public InputList<string> RoleIds
+        {
+            get => _roleIds ?? (_roleIds = new InputList<string>());
+            set => _roleIds = value;
+        }
+
+        public ListUsersInvokeArgs()
+        {
+        }
+        public static new ListUsersInvokeArgs Empty => new ListUsersInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ListUsersResult
+    {
+        public readonly int Total;
+        public readonly ImmutableArray<Outputs.User> Users;
+
+        [OutputConstructor]
+        private ListUsersResult(
+            int total,
+
+            ImmutableArray<Outputs.User> users)
+        {
+            Total = total;
+            Users = users;
+        }
+    }
+}
@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class LookupAsset
+    {
+        public static Task<LookupAssetResult> InvokeAsync(LookupAssetArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<LookupAssetResult>("marmot:index:lookupAsset", args ?? new LookupAssetArgs(), options.WithDefaults());
+
+        public static Output<LookupAssetResult> Invoke(LookupAssetInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<LookupAssetResult>("marmot:index:lookupAsset", args ?? new LookupAssetInvokeArgs(), options.WithDefaults());
+
+        public static Output<LookupAssetResult> Invoke(LookupAssetInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<LookupAssetResult>("marmot:index:lookupAsset", args ?? new LookupAssetInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class LookupAssetArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("allowMissing")]
+        public bool? AllowMissing { get; set; }
+
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        [Input("type", required: true)]
+        public string Type { get; set; } = null!;
+
+        public LookupAssetArgs()
+        {
+        }
+        public static new LookupAssetArgs Empty => new LookupAssetArgs();
+    }
+
+    public sealed class LookupAssetInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("allowMissing")]
+        public Input<bool>? AllowMissing { get; set; }
+
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public LookupAssetInvokeArgs()
+        {
+        }
+        public static new LookupAssetInvokeArgs Empty => new LookupAssetInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class LookupAssetResult
+    {
+        public readonly Outputs.CatalogAsset? Asset;
+
+        [OutputConstructor]
+        private LookupAssetResult(Outputs.CatalogAsset? asset)
+        {
+            Asset = asset;
+        }
+    }
+}
@@ -0,0 +1,70 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class MatchAssets
+    {
+        public static Task<MatchAssetsResult> InvokeAsync(MatchAssetsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<MatchAssetsResult>("marmot:index:matchAssets", args ?? new MatchAssetsArgs(), options.WithDefaults());
+
+        public static Output<MatchAssetsResult> Invoke(MatchAssetsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<MatchAssetsResult>("marmot:index:matchAssets", args ?? new MatchAssetsInvokeArgs(), options.WithDefaults());
+
+        public static Output<MatchAssetsResult> Invoke(MatchAssetsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<MatchAssetsResult>("marmot:index:matchAssets", args ?? new MatchAssetsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class MatchAssetsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("pattern", required: true)]
+        public string Pattern { get; set; } = null!;
+
+        [Input("type", required: true)]
+        public string Type { get; set; } = null!;
+
+        public MatchAssetsArgs()
+        {
+        }
+        public static new MatchAssetsArgs Empty => new MatchAssetsArgs();
+    }
+
+    public sealed class MatchAssetsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("pattern", required: true)]
+        public Input<string> Pattern { get; set; } = null!;
+
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public MatchAssetsInvokeArgs()
+        {
+        }
+        public static new MatchAssetsInvokeArgs Empty => new MatchAssetsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class MatchAssetsResult
+    {
+        public readonly ImmutableArray<Outputs.AssetMatch> Matches;
+        public readonly ImmutableArray<string> Mrns;
+
+        [OutputConstructor]
+        private MatchAssetsResult(
+            ImmutableArray<Outputs.AssetMatch> matches,
+
+            ImmutableArray<string> mrns)
+        {
+            Matches = matches;
+            Mrns = mrns;
+        }
+    }
+}
@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class AssetCollectionItem
+    {
+        public readonly string? Description;
+        public readonly ImmutableDictionary<string, Outputs.AssetEnvironment>? Environments;
+        public readonly ImmutableArray<Outputs.ExternalLink> ExternalLinks;
+        public readonly ImmutableArray<string> IgnoreMetadataKeys;
+        public readonly ImmutableDictionary<string, object>? Metadata;
+        public readonly string Name;
+        public readonly ImmutableDictionary<string, object>? Schema;
+        public readonly ImmutableArray<string> Services;
+        public readonly ImmutableArray<Outputs.AssetSource> Sources;
+        public readonly ImmutableArray<string> Tags;
+        public readonly string Type;
+
+        [OutputConstructor]
+        private AssetCollectionItem(
+            string? description,
+
+            ImmutableDictionary<string, Outputs.AssetEnvironment>? environments,
+
+            ImmutableArray<Outputs.ExternalLink> externalLinks,
+
+            ImmutableArray<string> ignoreMetadataKeys,
+
+            ImmutableDictionary<string, object>? metadata,
+
+            string name,
+
+            ImmutableDictionary<string, object>? schema,
+
+            ImmutableArray<string> services,
+
+            ImmutableArray<Outputs.AssetSource> sources,
+
+            ImmutableArray<string> tags,
+
+            string type)
+        {
+            Description = description;
+            Environments = environments;
+            ExternalLinks = externalLinks;
+            IgnoreMetadataKeys = ignoreMetadataKeys;
+            Metadata = metadata;
+            Name = name;
+            Schema = schema;
+            Services = services;
+            Sources = sources;
+            Tags = tags;
+            Type = type;
+        }
+    }
+}
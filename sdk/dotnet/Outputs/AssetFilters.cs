@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class AssetFilters
+    {
+        public readonly ImmutableDictionary<string, int> Services;
+        public readonly ImmutableDictionary<string, int> Tags;
+        public readonly ImmutableDictionary<string, int> Types;
+
+        [OutputConstructor]
+        private AssetFilters(
+            ImmutableDictionary<string, int> services,
+
+            ImmutableDictionary<string, int> tags,
+
+            ImmutableDictionary<string, int> types)
+        {
+            Services = services;
+            Tags = tags;
+            Types = types;
+        }
+    }
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class AssetMatch
+    {
+        public readonly string Mrn;
+        public readonly string Name;
+        public readonly string ResourceId;
+
+        [OutputConstructor]
+        private AssetMatch(
+            string mrn,
+
+            string name,
+
+            string resourceId)
+        {
+            Mrn = mrn;
+            Name = name;
+            ResourceId = resourceId;
+        }
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class AssetSummary
+    {
+        public readonly string Mrn;
+        public readonly string Name;
+        public readonly string? ParentMrn;
+        public readonly string ResourceId;
+        public readonly ImmutableArray<string> Services;
+        public readonly ImmutableArray<string> Tags;
+        public readonly string Type;
+        public readonly string UpdatedAt;
+
+        [OutputConstructor]
+        private AssetSummary(
+            string mrn,
+
+            string name,
+
+            string? parentMrn,
+
+            string resourceId,
+
+            ImmutableArray<string> services,
+
+            ImmutableArray<string> tags,
+
+            string type,
+
+            string updatedAt)
+        {
+            Mrn = mrn;
+            Name = name;
+            ParentMrn = parentMrn;
+            ResourceId = resourceId;
+            Services = services;
+            Tags = tags;
+            Type = type;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
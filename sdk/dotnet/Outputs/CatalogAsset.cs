@@ -0,0 +1,89 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class CatalogAsset
+    {
+        public readonly string CreatedAt;
+        public readonly string CreatedBy;
+        public readonly string? Description;
+        public readonly ImmutableDictionary<string, Outputs.AssetEnvironment>? Environments;
+        public readonly ImmutableArray<Outputs.ExternalLink> ExternalLinks;
+        public readonly string? LastSyncAt;
+        public readonly ImmutableDictionary<string, object>? Metadata;
+        public readonly string Mrn;
+        public readonly string Name;
+        public readonly string? ParentMrn;
+        public readonly string ResourceId;
+        public readonly ImmutableDictionary<string, object>? Schema;
+        public readonly ImmutableArray<string> Services;
+        public readonly ImmutableArray<Outputs.AssetSource> Sources;
+        public readonly ImmutableArray<string> Tags;
+        public readonly string Type;
+        public readonly string UpdatedAt;
+
+        [OutputConstructor]
+        private CatalogAsset(
+            string createdAt,
+
+            string createdBy,
+
+            string? description,
+
+            ImmutableDictionary<string, Outputs.AssetEnvironment>? environments,
+
+            ImmutableArray<Outputs.ExternalLink> externalLinks,
+
+            string? lastSyncAt,
+
+            ImmutableDictionary<string, object>? metadata,
+
+            string mrn,
+
+            string name,
+
+            string? parentMrn,
+
+            string resourceId,
+
+            ImmutableDictionary<string, object>? schema,
+
+            ImmutableArray<string> services,
+
+            ImmutableArray<Outputs.AssetSource> sources,
+
+            ImmutableArray<string> tags,
+
+            string type,
+
+            string updatedAt)
+        {
+            CreatedAt = createdAt;
+            CreatedBy = createdBy;
+            Description = description;
+            Environments = environments;
+            ExternalLinks = externalLinks;
+            LastSyncAt = lastSyncAt;
+            Metadata = metadata;
+            Mrn = mrn;
+            Name = name;
+            ParentMrn = parentMrn;
+            ResourceId = resourceId;
+            Schema = schema;
+            Services = services;
+            Sources = sources;
+            Tags = tags;
+            Type = type;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
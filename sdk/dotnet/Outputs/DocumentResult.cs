@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class DocumentResult
+    {
+        public readonly string? Error;
+        public readonly string Hash;
+        public readonly string Mrn;
+        public readonly string Status;
+
+        [OutputConstructor]
+        private DocumentResult(
+            string? error,
+
+            string hash,
+
+            string mrn,
+
+            string status)
+        {
+            Error = error;
+            Hash = hash;
+            Mrn = mrn;
+            Status = status;
+        }
+    }
+}
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class LineageEdge
+    {
+        public readonly string? JobMrn;
+        public readonly string ResourceId;
+        public readonly string Source;
+        public readonly string Target;
+        public readonly string? Type;
+
+        [OutputConstructor]
+        private LineageEdge(
+            string? jobMrn,
+
+            string resourceId,
+
+            string source,
+
+            string target,
+
+            string? type)
+        {
+            JobMrn = jobMrn;
+            ResourceId = resourceId;
+            Source = source;
+            Target = target;
+            Type = type;
+        }
+    }
+}
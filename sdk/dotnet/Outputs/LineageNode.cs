@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class LineageNode
+    {
+        public readonly Outputs.CatalogAsset? Asset;
+        public readonly int Depth;
+        public readonly string ResourceId;
+        public readonly string Type;
+
+        [OutputConstructor]
+        private LineageNode(
+            Outputs.CatalogAsset? asset,
+
+            int depth,
+
+            string resourceId,
+
+            string type)
+        {
+            Asset = asset;
+            Depth = depth;
+            ResourceId = resourceId;
+            Type = type;
+        }
+    }
+}
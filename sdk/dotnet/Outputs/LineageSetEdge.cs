@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class LineageSetEdge
+    {
+        public readonly string? JobMrn;
+        public readonly string Source;
+        public readonly string Target;
+
+        [OutputConstructor]
+        private LineageSetEdge(
+            string? jobMrn,
+
+            string source,
+
+            string target)
+        {
+            JobMrn = jobMrn;
+            Source = source;
+            Target = target;
+        }
+    }
+}
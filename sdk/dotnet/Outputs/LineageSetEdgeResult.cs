@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class LineageSetEdgeResult
+    {
+        public readonly string? JobMrn;
+        public readonly string ResourceId;
+        public readonly string Source;
+        public readonly string Status;
+        public readonly string Target;
+        public readonly string? Type;
+
+        [OutputConstructor]
+        private LineageSetEdgeResult(
+            string? jobMrn,
+
+            string resourceId,
+
+            string source,
+
+            string status,
+
+            string target,
+
+            string? type)
+        {
+            JobMrn = jobMrn;
+            ResourceId = resourceId;
+            Source = source;
+            Status = status;
+            Target = target;
+            Type = type;
+        }
+    }
+}
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class ManagedAsset
+    {
+        public readonly string Id;
+        public readonly string Mrn;
+        public readonly string Name;
+        public readonly string Type;
+        public readonly string Urn;
+
+        [OutputConstructor]
+        private ManagedAsset(
+            string id,
+
+            string mrn,
+
+            string name,
+
+            string type,
+
+            string urn)
+        {
+            Id = id;
+            Mrn = mrn;
+            Name = name;
+            Type = type;
+            Urn = urn;
+        }
+    }
+}
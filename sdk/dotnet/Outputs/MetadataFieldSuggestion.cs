@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class MetadataFieldSuggestion
+    {
+        public readonly int Count;
+        public readonly object? Example;
+        public readonly string Field;
+        public readonly ImmutableArray<string> PathParts;
+        public readonly string Type;
+        public readonly ImmutableArray<string> Types;
+
+        [OutputConstructor]
+        private MetadataFieldSuggestion(
+            int count,
+
+            object? example,
+
+            string field,
+
+            ImmutableArray<string> pathParts,
+
+            string type,
+
+            ImmutableArray<string> types)
+        {
+            Count = count;
+            Example = example;
+            Field = field;
+            PathParts = pathParts;
+            Type = type;
+            Types = types;
+        }
+    }
+}
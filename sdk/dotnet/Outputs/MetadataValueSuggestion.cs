@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class MetadataValueSuggestion
+    {
+        public readonly int Count;
+        public readonly string? ExampleMrn;
+        public readonly string Value;
+
+        [OutputConstructor]
+        private MetadataValueSuggestion(
+            int count,
+
+            string? exampleMrn,
+
+            string value)
+        {
+            Count = count;
+            ExampleMrn = exampleMrn;
+            Value = value;
+        }
+    }
+}
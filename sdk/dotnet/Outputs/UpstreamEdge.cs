@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class UpstreamEdge
+    {
+        public readonly bool? Created;
+        public readonly string? JobMrn;
+        public readonly string ResourceId;
+        public readonly string Source;
+
+        [OutputConstructor]
+        private UpstreamEdge(
+            bool? created,
+
+            string? jobMrn,
+
+            string resourceId,
+
+            string source)
+        {
+            Created = created;
+            JobMrn = jobMrn;
+            ResourceId = resourceId;
+            Source = source;
+        }
+    }
+}
@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class User
+    {
+        public readonly bool Active;
+        public readonly string? CreatedAt;
+        public readonly string Name;
+        public readonly string ResourceId;
+        public readonly ImmutableArray<Outputs.UserRole> Roles;
+        public readonly string? UpdatedAt;
+        public readonly string Username;
+
+        [OutputConstructor]
+        private User(
+            bool active,
+
+            string? createdAt,
+
+            string name,
+
+            string resourceId,
+
+            ImmutableArray<Outputs.UserRole> roles,
+
+            string? updatedAt,
+
+            string username)
+        {
+            Active = active;
+            CreatedAt = createdAt;
+            Name = name;
+            ResourceId = resourceId;
+            Roles = roles;
+            UpdatedAt = updatedAt;
+            Username = username;
+        }
+    }
+}
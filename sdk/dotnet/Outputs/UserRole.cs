@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot.Outputs
+{
+
+    [OutputType]
+    public sealed class UserRole
+    {
+        public readonly string? Description;
+        public readonly string Id;
+        public readonly string Name;
+        public readonly ImmutableArray<string> Permissions;
+
+        [OutputConstructor]
+        private UserRole(
+            string? description,
+
+            string id,
+
+            string name,
+
+            ImmutableArray<string> permissions)
+        {
+            Description = description;
+            Id = id;
+            Name = name;
+            Permissions = permissions;
+        }
+    }
+}
@@ -18,6 +18,9 @@ namespace Pulumi.Marmot
         [Output("host")]
         public Output<string> Host { get; private set; } = null!;
 
+        [Output("strictTags")]
+        public Output<string?> StrictTags { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Provider resource with the given unique name, arguments, and options.
@@ -46,12 +49,62 @@ namespace Pulumi.Marmot
 
     public sealed class ProviderArgs : global::Pulumi.ResourceArgs
     {
+        [Input("allowedTags", json: true)]
+        private InputList<string>? _allowedTags;
+        public InputList<string> AllowedTags
+        {
+            get => _allowedTags ?? (_allowedTags = new InputList<string>());
+            set => _allowedTags = value;
+        }
+
         [Input("apiKey", required: true)]
         public Input<string> ApiKey { get; set; } = null!;
 
+        [Input("batchCreates", json: true)]
+        public Input<bool>? BatchCreates { get; set; }
+
+        [Input("batchSize", json: true)]
+        public Input<int>? BatchSize { get; set; }
+
+        [Input("batchWindowMs", json: true)]
+        public Input<int>? BatchWindowMs { get; set; }
+
+        [Input("defaultMetadata", json: true)]
+        private InputMap<object>? _defaultMetadata;
+        public InputMap<object> DefaultMetadata
+        {
+            get => _defaultMetadata ?? (_defaultMetadata = new InputMap<object>());
+            set => _defaultMetadata = value;
+        }
+
+        [Input("defaultTags", json: true)]
+        private InputList<string>? _defaultTags;
+        public InputList<string> DefaultTags
+        {
+            get => _defaultTags ?? (_defaultTags = new InputList<string>());
+            set => _defaultTags = value;
+        }
+
+        [Input("failFast", json: true)]
+        public Input<bool>? FailFast { get; set; }
+
         [Input("host", required: true)]
         public Input<string> Host { get; set; } = null!;
 
+        [Input("ignoreMetadataKeys", json: true)]
+        private InputList<string>? _ignoreMetadataKeys;
+        public InputList<string> IgnoreMetadataKeys
+        {
+            get => _ignoreMetadataKeys ?? (_ignoreMetadataKeys = new InputList<string>());
+            set => _ignoreMetadataKeys = value;
+        }
+
+        [Input("provenance", json: true)]
+        public Input<bool>? Provenance { get; set; }
+
+        [Input("strictTags")]
+        public Input<string>? StrictTags { get; set; }
+
         public ProviderArgs()
         {
         }
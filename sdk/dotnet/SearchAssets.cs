@@ -0,0 +1,140 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    public static class SearchAssets
+    {
+        public static Task<SearchAssetsResult> InvokeAsync(SearchAssetsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<SearchAssetsResult>("marmot:index:searchAssets", args ?? new SearchAssetsArgs(), options.WithDefaults());
+
+        public static Output<SearchAssetsResult> Invoke(SearchAssetsInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<SearchAssetsResult>("marmot:index:searchAssets", args ?? new SearchAssetsInvokeArgs(), options.WithDefaults());
+
+        public static Output<SearchAssetsResult> Invoke(SearchAssetsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<SearchAssetsResult>("marmot:index:searchAssets", args ?? new SearchAssetsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class SearchAssetsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("calculateCounts")]
+        public bool? CalculateCounts { get; set; }
+
+        [Input("limit")]
+        public int? Limit { get; set; }
+
+        [Input("maxResults")]
+        public int? MaxResults { get; set; }
+
+        [Input("offset")]
+        public int? Offset { get; set; }
+
+        [Input("query")]
+        public string? Query { get; set; }
+
+        [Input("services")]
+        private List<string>? _services;
+        public List<string> Services
+        {
+            get => _services ?? (_services = new List<string>());
+            set => _services = value;
+        }
+
+        [Input("tags")]
+        private List<string>? _tags;
+        public List<string> Tags
+        {
+            get => _tags ?? (_tags = new List<string>());
+            set => _tags = value;
+        }
+
+        [Input("types")]
+        private List<string>? _types;
+        public List<string> Types
+        {
+            get => _types ?? (_types = new List<string>());
+            set => _types = value;
+        }
+
+        public SearchAssetsArgs()
+        {
+        }
+        public static new SearchAssetsArgs Empty => new SearchAssetsArgs();
+    }
+
+    public sealed class SearchAssetsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("calculateCounts")]
+        public Input<bool>? CalculateCounts { get; set; }
+
+        [Input("limit")]
+        public Input<int>? Limit { get; set; }
+
+        [Input("maxResults")]
+        public Input<int>? MaxResults { get; set; }
+
+        [Input("offset")]
+        public Input<int>? Offset { get; set; }
+
+        [Input("query")]
+        public Input<string>? Query { get; set; }
+
+        [Input("services")]
+        private InputList<string>? _services;
+        public InputList<string> Services
+        {
+            get => _services ?? (_services = new InputList<string>());
+            set => _services = value;
+        }
+
+        [Input("tags")]
+        private InputList<string>? _tags;
+        public InputList<string> Tags
+        {
+            get => _tags ?? (_tags = new InputList<string>());
+            set => _tags = value;
+        }
+
+        [Input("types")]
+        private InputList<string>? _types;
+        public InputList<string> Types
+        {
+            get => _types ?? (_types = new InputList<string>());
+            set => _types = value;
+        }
+
+        public SearchAssetsInvokeArgs()
+        {
+        }
+        public static new SearchAssetsInvokeArgs Empty => new SearchAssetsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class SearchAssetsResult
+    {
+        public readonly ImmutableArray<Outputs.CatalogAsset> Assets;
+        public readonly Outputs.AssetFilters Filters;
+        public readonly int Total;
+
+        [OutputConstructor]
+        private SearchAssetsResult(
+            ImmutableArray<Outputs.CatalogAsset> assets,
+
+            Outputs.AssetFilters filters,
+
+            int total)
+        {
+            Assets = assets;
+            Filters = filters;
+            Total = total;
+        }
+    }
+}
@@ -0,0 +1,94 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Marmot
+{
+    [MarmotResourceType("marmot:index:UpstreamLineage")]
+    public partial class UpstreamLineage : global::Pulumi.CustomResource
+    {
+        [Output("edges")]
+        public Output<ImmutableDictionary<string, Outputs.UpstreamEdge>> Edges { get; private set; } = null!;
+
+        [Output("jobMrn")]
+        public Output<string?> JobMrn { get; private set; } = null!;
+
+        [Output("sources")]
+        public Output<ImmutableArray<string>> Sources { get; private set; } = null!;
+
+        [Output("target")]
+        public Output<string> Target { get; private set; } = null!;
+
+        [Output("targetId")]
+        public Output<string> TargetId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a UpstreamLineage resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public UpstreamLineage(string name, UpstreamLineageArgs args, CustomResourceOptions? options = null)
+            : base("marmot:index:UpstreamLineage", name, args ?? new UpstreamLineageArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private UpstreamLineage(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("marmot:index:UpstreamLineage", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing UpstreamLineage resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static UpstreamLineage Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new UpstreamLineage(name, id, options);
+        }
+    }
+
+    public sealed class UpstreamLineageArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("jobMrn")]
+        public Input<string>? JobMrn { get; set; }
+
+        [Input("sources", required: true)]
+        private InputList<string>? _sources;
+        public InputList<string> Sources
+        {
+            get => _sources ?? (_sources = new InputList<string>());
+            set => _sources = value;
+        }
+
+        [Input("target", required: true)]
+        public Input<string> Target { get; set; } = null!;
+
+        public UpstreamLineageArgs()
+        {
+        }
+        public static new UpstreamLineageArgs Empty => new UpstreamLineageArgs();
+    }
+}
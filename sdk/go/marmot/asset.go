@@ -15,22 +15,26 @@ import (
 type Asset struct {
 	pulumi.CustomResourceState
 
-	CreatedAt     pulumi.StringOutput       `pulumi:"createdAt"`
-	CreatedBy     pulumi.StringOutput       `pulumi:"createdBy"`
-	Description   pulumi.StringPtrOutput    `pulumi:"description"`
-	Environments  AssetEnvironmentMapOutput `pulumi:"environments"`
-	ExternalLinks ExternalLinkArrayOutput   `pulumi:"externalLinks"`
-	LastSyncAt    pulumi.StringPtrOutput    `pulumi:"lastSyncAt"`
-	Metadata      pulumi.MapOutput          `pulumi:"metadata"`
-	Mrn           pulumi.StringOutput       `pulumi:"mrn"`
-	Name          pulumi.StringOutput       `pulumi:"name"`
-	ResourceId    pulumi.StringOutput       `pulumi:"resourceId"`
-	Schema        pulumi.MapOutput          `pulumi:"schema"`
-	Services      pulumi.StringArrayOutput  `pulumi:"services"`
-	Sources       AssetSourceArrayOutput    `pulumi:"sources"`
-	Tags          pulumi.StringArrayOutput  `pulumi:"tags"`
-	Type          pulumi.StringOutput       `pulumi:"type"`
-	UpdatedAt     pulumi.StringOutput       `pulumi:"updatedAt"`
+	CreatedAt          pulumi.StringOutput       `pulumi:"createdAt"`
+	CreatedBy          pulumi.StringOutput       `pulumi:"createdBy"`
+	Description        pulumi.StringPtrOutput    `pulumi:"description"`
+	Environments       AssetEnvironmentMapOutput `pulumi:"environments"`
+	ExternalLinks      ExternalLinkArrayOutput   `pulumi:"externalLinks"`
+	IgnoreMetadataKeys pulumi.StringArrayOutput  `pulumi:"ignoreMetadataKeys"`
+	LastSyncAt         pulumi.StringPtrOutput    `pulumi:"lastSyncAt"`
+	Metadata           pulumi.MapOutput          `pulumi:"metadata"`
+	Mrn                pulumi.StringOutput       `pulumi:"mrn"`
+	Name               pulumi.StringOutput       `pulumi:"name"`
+	ResourceId         pulumi.StringOutput       `pulumi:"resourceId"`
+	Schema             pulumi.MapOutput          `pulumi:"schema"`
+	SchemaFile         pulumi.StringPtrOutput    `pulumi:"schemaFile"`
+	SchemaFormat       pulumi.StringPtrOutput    `pulumi:"schemaFormat"`
+	SchemaMessage      pulumi.StringPtrOutput    `pulumi:"schemaMessage"`
+	Services           pulumi.StringArrayOutput  `pulumi:"services"`
+	Sources            AssetSourceArrayOutput    `pulumi:"sources"`
+	Tags               pulumi.StringArrayOutput  `pulumi:"tags"`
+	Type               pulumi.StringOutput       `pulumi:"type"`
+	UpdatedAt          pulumi.StringOutput       `pulumi:"updatedAt"`
 }
 
 // NewAsset registers a new resource with the given unique name, arguments, and options.
@@ -82,30 +86,38 @@ func (AssetState) ElementType() reflect.Type {
 }
 
 type assetArgs struct {
-	Description   *string                     `pulumi:"description"`
-	Environments  map[string]AssetEnvironment `pulumi:"environments"`
-	ExternalLinks []ExternalLink              `pulumi:"externalLinks"`
-	Metadata      map[string]interface{}      `pulumi:"metadata"`
-	Name          string                      `pulumi:"name"`
-	Schema        map[string]interface{}      `pulumi:"schema"`
-	Services      []string                    `pulumi:"services"`
-	Sources       []AssetSource               `pulumi:"sources"`
-	Tags          []string                    `pulumi:"tags"`
-	Type          string                      `pulumi:"type"`
+	Description        *string                     `pulumi:"description"`
+	Environments       map[string]AssetEnvironment `pulumi:"environments"`
+	ExternalLinks      []ExternalLink              `pulumi:"externalLinks"`
+	IgnoreMetadataKeys []string                    `pulumi:"ignoreMetadataKeys"`
+	Metadata           map[string]interface{}      `pulumi:"metadata"`
+	Name               string                      `pulumi:"name"`
+	Schema             map[string]interface{}      `pulumi:"schema"`
+	SchemaFile         *string                     `pulumi:"schemaFile"`
+	SchemaFormat       *string                     `pulumi:"schemaFormat"`
+	SchemaMessage      *string                     `pulumi:"schemaMessage"`
+	Services           []string                    `pulumi:"services"`
+	Sources            []AssetSource               `pulumi:"sources"`
+	Tags               []string                    `pulumi:"tags"`
+	Type               string                      `pulumi:"type"`
 }
 
 // The set of arguments for constructing a Asset resource.
 type AssetArgs struct {
-	Description   pulumi.StringPtrInput
-	Environments  AssetEnvironmentMapInput
-	ExternalLinks ExternalLinkArrayInput
-	Metadata      pulumi.MapInput
-	Name          pulumi.StringInput
-	Schema        pulumi.MapInput
-	Services      pulumi.StringArrayInput
-	Sources       AssetSourceArrayInput
-	Tags          pulumi.StringArrayInput
-	Type          pulumi.StringInput
+	Description        pulumi.StringPtrInput
+	Environments       AssetEnvironmentMapInput
+	ExternalLinks      ExternalLinkArrayInput
+	IgnoreMetadataKeys pulumi.StringArrayInput
+	Metadata           pulumi.MapInput
+	Name               pulumi.StringInput
+	Schema             pulumi.MapInput
+	SchemaFile         pulumi.StringPtrInput
+	SchemaFormat       pulumi.StringPtrInput
+	SchemaMessage      pulumi.StringPtrInput
+	Services           pulumi.StringArrayInput
+	Sources            AssetSourceArrayInput
+	Tags               pulumi.StringArrayInput
+	Type               pulumi.StringInput
 }
 
 func (AssetArgs) ElementType() reflect.Type {
@@ -215,6 +227,10 @@ func (o AssetOutput) ExternalLinks() ExternalLinkArrayOutput {
 	return o.ApplyT(func(v *Asset) ExternalLinkArrayOutput { return v.ExternalLinks }).(ExternalLinkArrayOutput)
 }
 
+func (o AssetOutput) IgnoreMetadataKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Asset) pulumi.StringArrayOutput { return v.IgnoreMetadataKeys }).(pulumi.StringArrayOutput)
+}
+
 func (o AssetOutput) LastSyncAt() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Asset) pulumi.StringPtrOutput { return v.LastSyncAt }).(pulumi.StringPtrOutput)
 }
@@ -239,6 +255,18 @@ func (o AssetOutput) Schema() pulumi.MapOutput {
 	return o.ApplyT(func(v *Asset) pulumi.MapOutput { return v.Schema }).(pulumi.MapOutput)
 }
 
+func (o AssetOutput) SchemaFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Asset) pulumi.StringPtrOutput { return v.SchemaFile }).(pulumi.StringPtrOutput)
+}
+
+func (o AssetOutput) SchemaFormat() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Asset) pulumi.StringPtrOutput { return v.SchemaFormat }).(pulumi.StringPtrOutput)
+}
+
+func (o AssetOutput) SchemaMessage() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Asset) pulumi.StringPtrOutput { return v.SchemaMessage }).(pulumi.StringPtrOutput)
+}
+
 func (o AssetOutput) Services() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Asset) pulumi.StringArrayOutput { return v.Services }).(pulumi.StringArrayOutput)
 }
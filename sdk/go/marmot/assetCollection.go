@@ -0,0 +1,227 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type AssetCollection struct {
+	pulumi.CustomResourceState
+
+	Assets      AssetCollectionItemMapOutput `pulumi:"assets"`
+	Config      pulumi.MapOutput             `pulumi:"config"`
+	Mrns        pulumi.StringMapOutput       `pulumi:"mrns"`
+	ResourceIds pulumi.StringMapOutput       `pulumi:"resourceIds"`
+}
+
+// NewAssetCollection registers a new resource with the given unique name, arguments, and options.
+func NewAssetCollection(ctx *pulumi.Context,
+	name string, args *AssetCollectionArgs, opts ...pulumi.ResourceOption) (*AssetCollection, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Assets == nil {
+		return nil, errors.New("invalid value for required argument 'Assets'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource AssetCollection
+	err := ctx.RegisterResource("marmot:index:AssetCollection", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetAssetCollection gets an existing AssetCollection resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetAssetCollection(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *AssetCollectionState, opts ...pulumi.ResourceOption) (*AssetCollection, error) {
+	var resource AssetCollection
+	err := ctx.ReadResource("marmot:index:AssetCollection", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering AssetCollection resources.
+type assetCollectionState struct {
+}
+
+type AssetCollectionState struct {
+}
+
+func (AssetCollectionState) ElementType() reflect.Type {
+	return reflect.TypeOf((*assetCollectionState)(nil)).Elem()
+}
+
+type assetCollectionArgs struct {
+	Assets map[string]AssetCollectionItem `pulumi:"assets"`
+	Config map[string]interface{}         `pulumi:"config"`
+}
+
+// The set of arguments for constructing a AssetCollection resource.
+type AssetCollectionArgs struct {
+	Assets AssetCollectionItemMapInput
+	Config pulumi.MapInput
+}
+
+func (AssetCollectionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*assetCollectionArgs)(nil)).Elem()
+}
+
+type AssetCollectionInput interface {
+	pulumi.Input
+
+	ToAssetCollectionOutput() AssetCollectionOutput
+	ToAssetCollectionOutputWithContext(ctx context.Context) AssetCollectionOutput
+}
+
+func (*AssetCollection) ElementType() reflect.Type {
+	return reflect.TypeOf((**AssetCollection)(nil)).Elem()
+}
+
+func (i *AssetCollection) ToAssetCollectionOutput() AssetCollectionOutput {
+	return i.ToAssetCollectionOutputWithContext(context.Background())
+}
+
+func (i *AssetCollection) ToAssetCollectionOutputWithContext(ctx context.Context) AssetCollectionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionOutput)
+}
+
+// AssetCollectionArrayInput is an input type that accepts AssetCollectionArray and AssetCollectionArrayOutput values.
+// You can construct a concrete instance of `AssetCollectionArrayInput` via:
+//
+//	AssetCollectionArray{ AssetCollectionArgs{...} }
+type AssetCollectionArrayInput interface {
+	pulumi.Input
+
+	ToAssetCollectionArrayOutput() AssetCollectionArrayOutput
+	ToAssetCollectionArrayOutputWithContext(context.Context) AssetCollectionArrayOutput
+}
+
+type AssetCollectionArray []AssetCollectionInput
+
+func (AssetCollectionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*AssetCollection)(nil)).Elem()
+}
+
+func (i AssetCollectionArray) ToAssetCollectionArrayOutput() AssetCollectionArrayOutput {
+	return i.ToAssetCollectionArrayOutputWithContext(context.Background())
+}
+
+func (i AssetCollectionArray) ToAssetCollectionArrayOutputWithContext(ctx context.Context) AssetCollectionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionArrayOutput)
+}
+
+// AssetCollectionMapInput is an input type that accepts AssetCollectionMap and AssetCollectionMapOutput values.
+// You can construct a concrete instance of `AssetCollectionMapInput` via:
+//
+//	AssetCollectionMap{ "key": AssetCollectionArgs{...} }
+type AssetCollectionMapInput interface {
+	pulumi.Input
+
+	ToAssetCollectionMapOutput() AssetCollectionMapOutput
+	ToAssetCollectionMapOutputWithContext(context.Context) AssetCollectionMapOutput
+}
+
+type AssetCollectionMap map[string]AssetCollectionInput
+
+func (AssetCollectionMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*AssetCollection)(nil)).Elem()
+}
+
+func (i AssetCollectionMap) ToAssetCollectionMapOutput() AssetCollectionMapOutput {
+	return i.ToAssetCollectionMapOutputWithContext(context.Background())
+}
+
+func (i AssetCollectionMap) ToAssetCollectionMapOutputWithContext(ctx context.Context) AssetCollectionMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionMapOutput)
+}
+
+type AssetCollectionOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AssetCollection)(nil)).Elem()
+}
+
+func (o AssetCollectionOutput) ToAssetCollectionOutput() AssetCollectionOutput {
+	return o
+}
+
+func (o AssetCollectionOutput) ToAssetCollectionOutputWithContext(ctx context.Context) AssetCollectionOutput {
+	return o
+}
+
+func (o AssetCollectionOutput) Assets() AssetCollectionItemMapOutput {
+	return o.ApplyT(func(v *AssetCollection) AssetCollectionItemMapOutput { return v.Assets }).(AssetCollectionItemMapOutput)
+}
+
+func (o AssetCollectionOutput) Config() pulumi.MapOutput {
+	return o.ApplyT(func(v *AssetCollection) pulumi.MapOutput { return v.Config }).(pulumi.MapOutput)
+}
+
+func (o AssetCollectionOutput) Mrns() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *AssetCollection) pulumi.StringMapOutput { return v.Mrns }).(pulumi.StringMapOutput)
+}
+
+func (o AssetCollectionOutput) ResourceIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *AssetCollection) pulumi.StringMapOutput { return v.ResourceIds }).(pulumi.StringMapOutput)
+}
+
+type AssetCollectionArrayOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*AssetCollection)(nil)).Elem()
+}
+
+func (o AssetCollectionArrayOutput) ToAssetCollectionArrayOutput() AssetCollectionArrayOutput {
+	return o
+}
+
+func (o AssetCollectionArrayOutput) ToAssetCollectionArrayOutputWithContext(ctx context.Context) AssetCollectionArrayOutput {
+	return o
+}
+
+func (o AssetCollectionArrayOutput) Index(i pulumi.IntInput) AssetCollectionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *AssetCollection {
+		return vs[0].([]*AssetCollection)[vs[1].(int)]
+	}).(AssetCollectionOutput)
+}
+
+type AssetCollectionMapOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*AssetCollection)(nil)).Elem()
+}
+
+func (o AssetCollectionMapOutput) ToAssetCollectionMapOutput() AssetCollectionMapOutput {
+	return o
+}
+
+func (o AssetCollectionMapOutput) ToAssetCollectionMapOutputWithContext(ctx context.Context) AssetCollectionMapOutput {
+	return o
+}
+
+func (o AssetCollectionMapOutput) MapIndex(k pulumi.StringInput) AssetCollectionOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *AssetCollection {
+		return vs[0].(map[string]*AssetCollection)[vs[1].(string)]
+	}).(AssetCollectionOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionInput)(nil)).Elem(), &AssetCollection{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionArrayInput)(nil)).Elem(), AssetCollectionArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionMapInput)(nil)).Elem(), AssetCollectionMap{})
+	pulumi.RegisterOutputType(AssetCollectionOutput{})
+	pulumi.RegisterOutputType(AssetCollectionArrayOutput{})
+	pulumi.RegisterOutputType(AssetCollectionMapOutput{})
+}
@@ -11,9 +11,39 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+func GetAllowedTags(ctx *pulumi.Context) string {
+	return config.Get(ctx, "marmot:allowedTags")
+}
 func GetApiKey(ctx *pulumi.Context) string {
 	return config.Get(ctx, "marmot:apiKey")
 }
+func GetBatchCreates(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "marmot:batchCreates")
+}
+func GetBatchSize(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "marmot:batchSize")
+}
+func GetBatchWindowMs(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "marmot:batchWindowMs")
+}
+func GetDefaultMetadata(ctx *pulumi.Context) string {
+	return config.Get(ctx, "marmot:defaultMetadata")
+}
+func GetDefaultTags(ctx *pulumi.Context) string {
+	return config.Get(ctx, "marmot:defaultTags")
+}
+func GetFailFast(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "marmot:failFast")
+}
 func GetHost(ctx *pulumi.Context) string {
 	return config.Get(ctx, "marmot:host")
 }
+func GetIgnoreMetadataKeys(ctx *pulumi.Context) string {
+	return config.Get(ctx, "marmot:ignoreMetadataKeys")
+}
+func GetProvenance(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "marmot:provenance")
+}
+func GetStrictTags(ctx *pulumi.Context) string {
+	return config.Get(ctx, "marmot:strictTags")
+}
@@ -0,0 +1,208 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type DataPipeline struct {
+	pulumi.ResourceState
+
+	Edges      pulumi.StringMapArrayOutput `pulumi:"edges"`
+	InputMrns  pulumi.StringArrayOutput    `pulumi:"inputMrns"`
+	JobMrn     pulumi.StringOutput         `pulumi:"jobMrn"`
+	OutputMrns pulumi.StringArrayOutput    `pulumi:"outputMrns"`
+}
+
+// NewDataPipeline registers a new resource with the given unique name, arguments, and options.
+func NewDataPipeline(ctx *pulumi.Context,
+	name string, args *DataPipelineArgs, opts ...pulumi.ResourceOption) (*DataPipeline, error) {
+	if args == nil {
+		args = &DataPipelineArgs{}
+	}
+
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource DataPipeline
+	err := ctx.RegisterRemoteComponentResource("marmot:index:DataPipeline", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type dataPipelineArgs struct {
+	InputMrns  []string              `pulumi:"inputMrns"`
+	Inputs     []AssetCollectionItem `pulumi:"inputs"`
+	Job        *AssetCollectionItem  `pulumi:"job"`
+	JobMrn     *string               `pulumi:"jobMrn"`
+	OutputMrns []string              `pulumi:"outputMrns"`
+	Outputs    []AssetCollectionItem `pulumi:"outputs"`
+}
+
+// The set of arguments for constructing a DataPipeline resource.
+type DataPipelineArgs struct {
+	InputMrns  pulumi.StringArrayInput
+	Inputs     AssetCollectionItemArrayInput
+	Job        AssetCollectionItemPtrInput
+	JobMrn     pulumi.StringPtrInput
+	OutputMrns pulumi.StringArrayInput
+	Outputs    AssetCollectionItemArrayInput
+}
+
+func (DataPipelineArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*dataPipelineArgs)(nil)).Elem()
+}
+
+type DataPipelineInput interface {
+	pulumi.Input
+
+	ToDataPipelineOutput() DataPipelineOutput
+	ToDataPipelineOutputWithContext(ctx context.Context) DataPipelineOutput
+}
+
+func (*DataPipeline) ElementType() reflect.Type {
+	return reflect.TypeOf((**DataPipeline)(nil)).Elem()
+}
+
+func (i *DataPipeline) ToDataPipelineOutput() DataPipelineOutput {
+	return i.ToDataPipelineOutputWithContext(context.Background())
+}
+
+func (i *DataPipeline) ToDataPipelineOutputWithContext(ctx context.Context) DataPipelineOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DataPipelineOutput)
+}
+
+// DataPipelineArrayInput is an input type that accepts DataPipelineArray and DataPipelineArrayOutput values.
+// You can construct a concrete instance of `DataPipelineArrayInput` via:
+//
+//	DataPipelineArray{ DataPipelineArgs{...} }
+type DataPipelineArrayInput interface {
+	pulumi.Input
+
+	ToDataPipelineArrayOutput() DataPipelineArrayOutput
+	ToDataPipelineArrayOutputWithContext(context.Context) DataPipelineArrayOutput
+}
+
+type DataPipelineArray []DataPipelineInput
+
+func (DataPipelineArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DataPipeline)(nil)).Elem()
+}
+
+func (i DataPipelineArray) ToDataPipelineArrayOutput() DataPipelineArrayOutput {
+	return i.ToDataPipelineArrayOutputWithContext(context.Background())
+}
+
+func (i DataPipelineArray) ToDataPipelineArrayOutputWithContext(ctx context.Context) DataPipelineArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DataPipelineArrayOutput)
+}
+
+// DataPipelineMapInput is an input type that accepts DataPipelineMap and DataPipelineMapOutput values.
+// You can construct a concrete instance of `DataPipelineMapInput` via:
+//
+//	DataPipelineMap{ "key": DataPipelineArgs{...} }
+type DataPipelineMapInput interface {
+	pulumi.Input
+
+	ToDataPipelineMapOutput() DataPipelineMapOutput
+	ToDataPipelineMapOutputWithContext(context.Context) DataPipelineMapOutput
+}
+
+type DataPipelineMap map[string]DataPipelineInput
+
+func (DataPipelineMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DataPipeline)(nil)).Elem()
+}
+
+func (i DataPipelineMap) ToDataPipelineMapOutput() DataPipelineMapOutput {
+	return i.ToDataPipelineMapOutputWithContext(context.Background())
+}
+
+func (i DataPipelineMap) ToDataPipelineMapOutputWithContext(ctx context.Context) DataPipelineMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DataPipelineMapOutput)
+}
+
+type DataPipelineOutput struct{ *pulumi.OutputState }
+
+func (DataPipelineOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DataPipeline)(nil)).Elem()
+}
+
+func (o DataPipelineOutput) ToDataPipelineOutput() DataPipelineOutput {
+	return o
+}
+
+func (o DataPipelineOutput) ToDataPipelineOutputWithContext(ctx context.Context) DataPipelineOutput {
+	return o
+}
+
+func (o DataPipelineOutput) Edges() pulumi.StringMapArrayOutput {
+	return o.ApplyT(func(v *DataPipeline) pulumi.StringMapArrayOutput { return v.Edges }).(pulumi.StringMapArrayOutput)
+}
+
+func (o DataPipelineOutput) InputMrns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DataPipeline) pulumi.StringArrayOutput { return v.InputMrns }).(pulumi.StringArrayOutput)
+}
+
+func (o DataPipelineOutput) JobMrn() pulumi.StringOutput {
+	return o.ApplyT(func(v *DataPipeline) pulumi.StringOutput { return v.JobMrn }).(pulumi.StringOutput)
+}
+
+func (o DataPipelineOutput) OutputMrns() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DataPipeline) pulumi.StringArrayOutput { return v.OutputMrns }).(pulumi.StringArrayOutput)
+}
+
+type DataPipelineArrayOutput struct{ *pulumi.OutputState }
+
+func (DataPipelineArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DataPipeline)(nil)).Elem()
+}
+
+func (o DataPipelineArrayOutput) ToDataPipelineArrayOutput() DataPipelineArrayOutput {
+	return o
+}
+
+func (o DataPipelineArrayOutput) ToDataPipelineArrayOutputWithContext(ctx context.Context) DataPipelineArrayOutput {
+	return o
+}
+
+func (o DataPipelineArrayOutput) Index(i pulumi.IntInput) DataPipelineOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *DataPipeline {
+		return vs[0].([]*DataPipeline)[vs[1].(int)]
+	}).(DataPipelineOutput)
+}
+
+type DataPipelineMapOutput struct{ *pulumi.OutputState }
+
+func (DataPipelineMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DataPipeline)(nil)).Elem()
+}
+
+func (o DataPipelineMapOutput) ToDataPipelineMapOutput() DataPipelineMapOutput {
+	return o
+}
+
+func (o DataPipelineMapOutput) ToDataPipelineMapOutputWithContext(ctx context.Context) DataPipelineMapOutput {
+	return o
+}
+
+func (o DataPipelineMapOutput) MapIndex(k pulumi.StringInput) DataPipelineOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *DataPipeline {
+		return vs[0].(map[string]*DataPipeline)[vs[1].(string)]
+	}).(DataPipelineOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DataPipelineInput)(nil)).Elem(), &DataPipeline{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DataPipelineArrayInput)(nil)).Elem(), DataPipelineArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DataPipelineMapInput)(nil)).Elem(), DataPipelineMap{})
+	pulumi.RegisterOutputType(DataPipelineOutput{})
+	pulumi.RegisterOutputType(DataPipelineArrayOutput{})
+	pulumi.RegisterOutputType(DataPipelineMapOutput{})
+}
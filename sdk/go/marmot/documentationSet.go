@@ -0,0 +1,236 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type DocumentationSet struct {
+	pulumi.CustomResourceState
+
+	Directory   pulumi.StringOutput         `pulumi:"directory"`
+	Documents   DocumentResultMapOutput     `pulumi:"documents"`
+	GlobalDocs  pulumi.StringArrayMapOutput `pulumi:"globalDocs"`
+	MrnTemplate pulumi.StringPtrOutput      `pulumi:"mrnTemplate"`
+	Source      pulumi.StringPtrOutput      `pulumi:"source"`
+}
+
+// NewDocumentationSet registers a new resource with the given unique name, arguments, and options.
+func NewDocumentationSet(ctx *pulumi.Context,
+	name string, args *DocumentationSetArgs, opts ...pulumi.ResourceOption) (*DocumentationSet, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Directory == nil {
+		return nil, errors.New("invalid value for required argument 'Directory'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource DocumentationSet
+	err := ctx.RegisterResource("marmot:index:DocumentationSet", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDocumentationSet gets an existing DocumentationSet resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDocumentationSet(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DocumentationSetState, opts ...pulumi.ResourceOption) (*DocumentationSet, error) {
+	var resource DocumentationSet
+	err := ctx.ReadResource("marmot:index:DocumentationSet", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering DocumentationSet resources.
+type documentationSetState struct {
+}
+
+type DocumentationSetState struct {
+}
+
+func (DocumentationSetState) ElementType() reflect.Type {
+	return reflect.TypeOf((*documentationSetState)(nil)).Elem()
+}
+
+type documentationSetArgs struct {
+	Directory   string              `pulumi:"directory"`
+	GlobalDocs  map[string][]string `pulumi:"globalDocs"`
+	MrnTemplate *string             `pulumi:"mrnTemplate"`
+	Source      *string             `pulumi:"source"`
+}
+
+// The set of arguments for constructing a DocumentationSet resource.
+type DocumentationSetArgs struct {
+	Directory   pulumi.StringInput
+	GlobalDocs  pulumi.StringArrayMapInput
+	MrnTemplate pulumi.StringPtrInput
+	Source      pulumi.StringPtrInput
+}
+
+func (DocumentationSetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*documentationSetArgs)(nil)).Elem()
+}
+
+type DocumentationSetInput interface {
+	pulumi.Input
+
+	ToDocumentationSetOutput() DocumentationSetOutput
+	ToDocumentationSetOutputWithContext(ctx context.Context) DocumentationSetOutput
+}
+
+func (*DocumentationSet) ElementType() reflect.Type {
+	return reflect.TypeOf((**DocumentationSet)(nil)).Elem()
+}
+
+func (i *DocumentationSet) ToDocumentationSetOutput() DocumentationSetOutput {
+	return i.ToDocumentationSetOutputWithContext(context.Background())
+}
+
+func (i *DocumentationSet) ToDocumentationSetOutputWithContext(ctx context.Context) DocumentationSetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DocumentationSetOutput)
+}
+
+// DocumentationSetArrayInput is an input type that accepts DocumentationSetArray and DocumentationSetArrayOutput values.
+// You can construct a concrete instance of `DocumentationSetArrayInput` via:
+//
+//	DocumentationSetArray{ DocumentationSetArgs{...} }
+type DocumentationSetArrayInput interface {
+	pulumi.Input
+
+	ToDocumentationSetArrayOutput() DocumentationSetArrayOutput
+	ToDocumentationSetArrayOutputWithContext(context.Context) DocumentationSetArrayOutput
+}
+
+type DocumentationSetArray []DocumentationSetInput
+
+func (DocumentationSetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DocumentationSet)(nil)).Elem()
+}
+
+func (i DocumentationSetArray) ToDocumentationSetArrayOutput() DocumentationSetArrayOutput {
+	return i.ToDocumentationSetArrayOutputWithContext(context.Background())
+}
+
+func (i DocumentationSetArray) ToDocumentationSetArrayOutputWithContext(ctx context.Context) DocumentationSetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DocumentationSetArrayOutput)
+}
+
+// DocumentationSetMapInput is an input type that accepts DocumentationSetMap and DocumentationSetMapOutput values.
+// You can construct a concrete instance of `DocumentationSetMapInput` via:
+//
+//	DocumentationSetMap{ "key": DocumentationSetArgs{...} }
+type DocumentationSetMapInput interface {
+	pulumi.Input
+
+	ToDocumentationSetMapOutput() DocumentationSetMapOutput
+	ToDocumentationSetMapOutputWithContext(context.Context) DocumentationSetMapOutput
+}
+
+type DocumentationSetMap map[string]DocumentationSetInput
+
+func (DocumentationSetMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DocumentationSet)(nil)).Elem()
+}
+
+func (i DocumentationSetMap) ToDocumentationSetMapOutput() DocumentationSetMapOutput {
+	return i.ToDocumentationSetMapOutputWithContext(context.Background())
+}
+
+func (i DocumentationSetMap) ToDocumentationSetMapOutputWithContext(ctx context.Context) DocumentationSetMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DocumentationSetMapOutput)
+}
+
+type DocumentationSetOutput struct{ *pulumi.OutputState }
+
+func (DocumentationSetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DocumentationSet)(nil)).Elem()
+}
+
+func (o DocumentationSetOutput) ToDocumentationSetOutput() DocumentationSetOutput {
+	return o
+}
+
+func (o DocumentationSetOutput) ToDocumentationSetOutputWithContext(ctx context.Context) DocumentationSetOutput {
+	return o
+}
+
+func (o DocumentationSetOutput) Directory() pulumi.StringOutput {
+	return o.ApplyT(func(v *DocumentationSet) pulumi.StringOutput { return v.Directory }).(pulumi.StringOutput)
+}
+
+func (o DocumentationSetOutput) Documents() DocumentResultMapOutput {
+	return o.ApplyT(func(v *DocumentationSet) DocumentResultMapOutput { return v.Documents }).(DocumentResultMapOutput)
+}
+
+func (o DocumentationSetOutput) GlobalDocs() pulumi.StringArrayMapOutput {
+	return o.ApplyT(func(v *DocumentationSet) pulumi.StringArrayMapOutput { return v.GlobalDocs }).(pulumi.StringArrayMapOutput)
+}
+
+func (o DocumentationSetOutput) MrnTemplate() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DocumentationSet) pulumi.StringPtrOutput { return v.MrnTemplate }).(pulumi.StringPtrOutput)
+}
+
+func (o DocumentationSetOutput) Source() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DocumentationSet) pulumi.StringPtrOutput { return v.Source }).(pulumi.StringPtrOutput)
+}
+
+type DocumentationSetArrayOutput struct{ *pulumi.OutputState }
+
+func (DocumentationSetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DocumentationSet)(nil)).Elem()
+}
+
+func (o DocumentationSetArrayOutput) ToDocumentationSetArrayOutput() DocumentationSetArrayOutput {
+	return o
+}
+
+func (o DocumentationSetArrayOutput) ToDocumentationSetArrayOutputWithContext(ctx context.Context) DocumentationSetArrayOutput {
+	return o
+}
+
+func (o DocumentationSetArrayOutput) Index(i pulumi.IntInput) DocumentationSetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *DocumentationSet {
+		return vs[0].([]*DocumentationSet)[vs[1].(int)]
+	}).(DocumentationSetOutput)
+}
+
+type DocumentationSetMapOutput struct{ *pulumi.OutputState }
+
+func (DocumentationSetMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DocumentationSet)(nil)).Elem()
+}
+
+func (o DocumentationSetMapOutput) ToDocumentationSetMapOutput() DocumentationSetMapOutput {
+	return o
+}
+
+func (o DocumentationSetMapOutput) ToDocumentationSetMapOutputWithContext(ctx context.Context) DocumentationSetMapOutput {
+	return o
+}
+
+func (o DocumentationSetMapOutput) MapIndex(k pulumi.StringInput) DocumentationSetOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *DocumentationSet {
+		return vs[0].(map[string]*DocumentationSet)[vs[1].(string)]
+	}).(DocumentationSetOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DocumentationSetInput)(nil)).Elem(), &DocumentationSet{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DocumentationSetArrayInput)(nil)).Elem(), DocumentationSetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DocumentationSetMapInput)(nil)).Elem(), DocumentationSetMap{})
+	pulumi.RegisterOutputType(DocumentationSetOutput{})
+	pulumi.RegisterOutputType(DocumentationSetArrayOutput{})
+	pulumi.RegisterOutputType(DocumentationSetMapOutput{})
+}
@@ -0,0 +1,151 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func GetCatalogAsset(ctx *pulumi.Context, args *GetCatalogAssetArgs, opts ...pulumi.InvokeOption) (*GetCatalogAssetResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetCatalogAssetResult
+	err := ctx.Invoke("marmot:index:getCatalogAsset", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetCatalogAssetArgs struct {
+	Mrn        *string `pulumi:"mrn"`
+	ResourceId *string `pulumi:"resourceId"`
+}
+
+type GetCatalogAssetResult struct {
+	CreatedAt     string                      `pulumi:"createdAt"`
+	CreatedBy     string                      `pulumi:"createdBy"`
+	Description   *string                     `pulumi:"description"`
+	Environments  map[string]AssetEnvironment `pulumi:"environments"`
+	ExternalLinks []ExternalLink              `pulumi:"externalLinks"`
+	LastSyncAt    *string                     `pulumi:"lastSyncAt"`
+	Metadata      map[string]interface{}      `pulumi:"metadata"`
+	Mrn           string                      `pulumi:"mrn"`
+	Name          string                      `pulumi:"name"`
+	ParentMrn     *string                     `pulumi:"parentMrn"`
+	ResourceId    string                      `pulumi:"resourceId"`
+	Schema        map[string]interface{}      `pulumi:"schema"`
+	Services      []string                    `pulumi:"services"`
+	Sources       []AssetSource               `pulumi:"sources"`
+	Tags          []string                    `pulumi:"tags"`
+	Type          string                      `pulumi:"type"`
+	UpdatedAt     string                      `pulumi:"updatedAt"`
+}
+
+func GetCatalogAssetOutput(ctx *pulumi.Context, args GetCatalogAssetOutputArgs, opts ...pulumi.InvokeOption) GetCatalogAssetResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetCatalogAssetResultOutput, error) {
+			args := v.(GetCatalogAssetArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("marmot:index:getCatalogAsset", args, GetCatalogAssetResultOutput{}, options).(GetCatalogAssetResultOutput), nil
+		}).(GetCatalogAssetResultOutput)
+}
+
+type GetCatalogAssetOutputArgs struct {
+	Mrn        pulumi.StringPtrInput `pulumi:"mrn"`
+	ResourceId pulumi.StringPtrInput `pulumi:"resourceId"`
+}
+
+func (GetCatalogAssetOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetCatalogAssetArgs)(nil)).Elem()
+}
+
+type GetCatalogAssetResultOutput struct{ *pulumi.OutputState }
+
+func (GetCatalogAssetResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetCatalogAssetResult)(nil)).Elem()
+}
+
+func (o GetCatalogAssetResultOutput) ToGetCatalogAssetResultOutput() GetCatalogAssetResultOutput {
+	return o
+}
+
+func (o GetCatalogAssetResultOutput) ToGetCatalogAssetResultOutputWithContext(ctx context.Context) GetCatalogAssetResultOutput {
+	return o
+}
+
+func (o GetCatalogAssetResultOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+func (o GetCatalogAssetResultOutput) CreatedBy() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.CreatedBy }).(pulumi.StringOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Environments() AssetEnvironmentMapOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) map[string]AssetEnvironment { return v.Environments }).(AssetEnvironmentMapOutput)
+}
+
+func (o GetCatalogAssetResultOutput) ExternalLinks() ExternalLinkArrayOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) []ExternalLink { return v.ExternalLinks }).(ExternalLinkArrayOutput)
+}
+
+func (o GetCatalogAssetResultOutput) LastSyncAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) *string { return v.LastSyncAt }).(pulumi.StringPtrOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Metadata() pulumi.MapOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) map[string]interface{} { return v.Metadata }).(pulumi.MapOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Mrn() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.Mrn }).(pulumi.StringOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o GetCatalogAssetResultOutput) ParentMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) *string { return v.ParentMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o GetCatalogAssetResultOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Schema() pulumi.MapOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) map[string]interface{} { return v.Schema }).(pulumi.MapOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Services() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) []string { return v.Services }).(pulumi.StringArrayOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Sources() AssetSourceArrayOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) []AssetSource { return v.Sources }).(AssetSourceArrayOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) []string { return v.Tags }).(pulumi.StringArrayOutput)
+}
+
+func (o GetCatalogAssetResultOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o GetCatalogAssetResultOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCatalogAssetResult) string { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetCatalogAssetResultOutput{})
+}
@@ -0,0 +1,78 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func LookupLineage(ctx *pulumi.Context, args *LookupLineageArgs, opts ...pulumi.InvokeOption) (*LookupLineageResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupLineageResult
+	err := ctx.Invoke("marmot:index:getLineage", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupLineageArgs struct {
+	Depth      *int    `pulumi:"depth"`
+	Direction  *string `pulumi:"direction"`
+	ResourceId string  `pulumi:"resourceId"`
+}
+
+type LookupLineageResult struct {
+	Edges []LineageEdge `pulumi:"edges"`
+	Nodes []LineageNode `pulumi:"nodes"`
+}
+
+func LookupLineageOutput(ctx *pulumi.Context, args LookupLineageOutputArgs, opts ...pulumi.InvokeOption) LookupLineageResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupLineageResultOutput, error) {
+			args := v.(LookupLineageArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("marmot:index:getLineage", args, LookupLineageResultOutput{}, options).(LookupLineageResultOutput), nil
+		}).(LookupLineageResultOutput)
+}
+
+type LookupLineageOutputArgs struct {
+	Depth      pulumi.IntPtrInput    `pulumi:"depth"`
+	Direction  pulumi.StringPtrInput `pulumi:"direction"`
+	ResourceId pulumi.StringInput    `pulumi:"resourceId"`
+}
+
+func (LookupLineageOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupLineageArgs)(nil)).Elem()
+}
+
+type LookupLineageResultOutput struct{ *pulumi.OutputState }
+
+func (LookupLineageResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupLineageResult)(nil)).Elem()
+}
+
+func (o LookupLineageResultOutput) ToLookupLineageResultOutput() LookupLineageResultOutput {
+	return o
+}
+
+func (o LookupLineageResultOutput) ToLookupLineageResultOutputWithContext(ctx context.Context) LookupLineageResultOutput {
+	return o
+}
+
+func (o LookupLineageResultOutput) Edges() LineageEdgeArrayOutput {
+	return o.ApplyT(func(v LookupLineageResult) []LineageEdge { return v.Edges }).(LineageEdgeArrayOutput)
+}
+
+func (o LookupLineageResultOutput) Nodes() LineageNodeArrayOutput {
+	return o.ApplyT(func(v LookupLineageResult) []LineageNode { return v.Nodes }).(LineageNodeArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupLineageResultOutput{})
+}
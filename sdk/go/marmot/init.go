@@ -23,8 +23,18 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 	switch typ {
 	case "marmot:index:Asset":
 		r = &Asset{}
+	case "marmot:index:AssetCollection":
+		r = &AssetCollection{}
+	case "marmot:index:DataPipeline":
+		r = &DataPipeline{}
+	case "marmot:index:DocumentationSet":
+		r = &DocumentationSet{}
 	case "marmot:index:Lineage":
 		r = &Lineage{}
+	case "marmot:index:LineageSet":
+		r = &LineageSet{}
+	case "marmot:index:UpstreamLineage":
+		r = &UpstreamLineage{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
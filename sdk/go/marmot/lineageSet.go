@@ -0,0 +1,215 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type LineageSet struct {
+	pulumi.CustomResourceState
+
+	Edges   LineageSetEdgeArrayOutput       `pulumi:"edges"`
+	Results LineageSetEdgeResultArrayOutput `pulumi:"results"`
+}
+
+// NewLineageSet registers a new resource with the given unique name, arguments, and options.
+func NewLineageSet(ctx *pulumi.Context,
+	name string, args *LineageSetArgs, opts ...pulumi.ResourceOption) (*LineageSet, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Edges == nil {
+		return nil, errors.New("invalid value for required argument 'Edges'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource LineageSet
+	err := ctx.RegisterResource("marmot:index:LineageSet", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetLineageSet gets an existing LineageSet resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetLineageSet(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *LineageSetState, opts ...pulumi.ResourceOption) (*LineageSet, error) {
+	var resource LineageSet
+	err := ctx.ReadResource("marmot:index:LineageSet", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering LineageSet resources.
+type lineageSetState struct {
+}
+
+type LineageSetState struct {
+}
+
+func (LineageSetState) ElementType() reflect.Type {
+	return reflect.TypeOf((*lineageSetState)(nil)).Elem()
+}
+
+type lineageSetArgs struct {
+	Edges []LineageSetEdge `pulumi:"edges"`
+}
+
+// The set of arguments for constructing a LineageSet resource.
+type LineageSetArgs struct {
+	Edges LineageSetEdgeArrayInput
+}
+
+func (LineageSetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*lineageSetArgs)(nil)).Elem()
+}
+
+type LineageSetInput interface {
+	pulumi.Input
+
+	ToLineageSetOutput() LineageSetOutput
+	ToLineageSetOutputWithContext(ctx context.Context) LineageSetOutput
+}
+
+func (*LineageSet) ElementType() reflect.Type {
+	return reflect.TypeOf((**LineageSet)(nil)).Elem()
+}
+
+func (i *LineageSet) ToLineageSetOutput() LineageSetOutput {
+	return i.ToLineageSetOutputWithContext(context.Background())
+}
+
+func (i *LineageSet) ToLineageSetOutputWithContext(ctx context.Context) LineageSetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetOutput)
+}
+
+// LineageSetArrayInput is an input type that accepts LineageSetArray and LineageSetArrayOutput values.
+// You can construct a concrete instance of `LineageSetArrayInput` via:
+//
+//	LineageSetArray{ LineageSetArgs{...} }
+type LineageSetArrayInput interface {
+	pulumi.Input
+
+	ToLineageSetArrayOutput() LineageSetArrayOutput
+	ToLineageSetArrayOutputWithContext(context.Context) LineageSetArrayOutput
+}
+
+type LineageSetArray []LineageSetInput
+
+func (LineageSetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*LineageSet)(nil)).Elem()
+}
+
+func (i LineageSetArray) ToLineageSetArrayOutput() LineageSetArrayOutput {
+	return i.ToLineageSetArrayOutputWithContext(context.Background())
+}
+
+func (i LineageSetArray) ToLineageSetArrayOutputWithContext(ctx context.Context) LineageSetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetArrayOutput)
+}
+
+// LineageSetMapInput is an input type that accepts LineageSetMap and LineageSetMapOutput values.
+// You can construct a concrete instance of `LineageSetMapInput` via:
+//
+//	LineageSetMap{ "key": LineageSetArgs{...} }
+type LineageSetMapInput interface {
+	pulumi.Input
+
+	ToLineageSetMapOutput() LineageSetMapOutput
+	ToLineageSetMapOutputWithContext(context.Context) LineageSetMapOutput
+}
+
+type LineageSetMap map[string]LineageSetInput
+
+func (LineageSetMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*LineageSet)(nil)).Elem()
+}
+
+func (i LineageSetMap) ToLineageSetMapOutput() LineageSetMapOutput {
+	return i.ToLineageSetMapOutputWithContext(context.Background())
+}
+
+func (i LineageSetMap) ToLineageSetMapOutputWithContext(ctx context.Context) LineageSetMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetMapOutput)
+}
+
+type LineageSetOutput struct{ *pulumi.OutputState }
+
+func (LineageSetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**LineageSet)(nil)).Elem()
+}
+
+func (o LineageSetOutput) ToLineageSetOutput() LineageSetOutput {
+	return o
+}
+
+func (o LineageSetOutput) ToLineageSetOutputWithContext(ctx context.Context) LineageSetOutput {
+	return o
+}
+
+func (o LineageSetOutput) Edges() LineageSetEdgeArrayOutput {
+	return o.ApplyT(func(v *LineageSet) LineageSetEdgeArrayOutput { return v.Edges }).(LineageSetEdgeArrayOutput)
+}
+
+func (o LineageSetOutput) Results() LineageSetEdgeResultArrayOutput {
+	return o.ApplyT(func(v *LineageSet) LineageSetEdgeResultArrayOutput { return v.Results }).(LineageSetEdgeResultArrayOutput)
+}
+
+type LineageSetArrayOutput struct{ *pulumi.OutputState }
+
+func (LineageSetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*LineageSet)(nil)).Elem()
+}
+
+func (o LineageSetArrayOutput) ToLineageSetArrayOutput() LineageSetArrayOutput {
+	return o
+}
+
+func (o LineageSetArrayOutput) ToLineageSetArrayOutputWithContext(ctx context.Context) LineageSetArrayOutput {
+	return o
+}
+
+func (o LineageSetArrayOutput) Index(i pulumi.IntInput) LineageSetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *LineageSet {
+		return vs[0].([]*LineageSet)[vs[1].(int)]
+	}).(LineageSetOutput)
+}
+
+type LineageSetMapOutput struct{ *pulumi.OutputState }
+
+func (LineageSetMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*LineageSet)(nil)).Elem()
+}
+
+func (o LineageSetMapOutput) ToLineageSetMapOutput() LineageSetMapOutput {
+	return o
+}
+
+func (o LineageSetMapOutput) ToLineageSetMapOutputWithContext(ctx context.Context) LineageSetMapOutput {
+	return o
+}
+
+func (o LineageSetMapOutput) MapIndex(k pulumi.StringInput) LineageSetOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *LineageSet {
+		return vs[0].(map[string]*LineageSet)[vs[1].(string)]
+	}).(LineageSetOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetInput)(nil)).Elem(), &LineageSet{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetArrayInput)(nil)).Elem(), LineageSetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetMapInput)(nil)).Elem(), LineageSetMap{})
+	pulumi.RegisterOutputType(LineageSetOutput{})
+	pulumi.RegisterOutputType(LineageSetArrayOutput{})
+	pulumi.RegisterOutputType(LineageSetMapOutput{})
+}
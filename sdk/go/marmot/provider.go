@@ -15,8 +15,9 @@ import (
 type Provider struct {
 	pulumi.ProviderResourceState
 
-	ApiKey pulumi.StringOutput `pulumi:"apiKey"`
-	Host   pulumi.StringOutput `pulumi:"host"`
+	ApiKey     pulumi.StringOutput    `pulumi:"apiKey"`
+	Host       pulumi.StringOutput    `pulumi:"host"`
+	StrictTags pulumi.StringPtrOutput `pulumi:"strictTags"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -42,14 +43,34 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
-	ApiKey string `pulumi:"apiKey"`
-	Host   string `pulumi:"host"`
+	AllowedTags        []string               `pulumi:"allowedTags"`
+	ApiKey             string                 `pulumi:"apiKey"`
+	BatchCreates       *bool                  `pulumi:"batchCreates"`
+	BatchSize          *int                   `pulumi:"batchSize"`
+	BatchWindowMs      *int                   `pulumi:"batchWindowMs"`
+	DefaultMetadata    map[string]interface{} `pulumi:"defaultMetadata"`
+	DefaultTags        []string               `pulumi:"defaultTags"`
+	FailFast           *bool                  `pulumi:"failFast"`
+	Host               string                 `pulumi:"host"`
+	IgnoreMetadataKeys []string               `pulumi:"ignoreMetadataKeys"`
+	Provenance         *bool                  `pulumi:"provenance"`
+	StrictTags         *string                `pulumi:"strictTags"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
-	ApiKey pulumi.StringInput
-	Host   pulumi.StringInput
+	AllowedTags        pulumi.StringArrayInput
+	ApiKey             pulumi.StringInput
+	BatchCreates       pulumi.BoolPtrInput
+	BatchSize          pulumi.IntPtrInput
+	BatchWindowMs      pulumi.IntPtrInput
+	DefaultMetadata    pulumi.MapInput
+	DefaultTags        pulumi.StringArrayInput
+	FailFast           pulumi.BoolPtrInput
+	Host               pulumi.StringInput
+	IgnoreMetadataKeys pulumi.StringArrayInput
+	Provenance         pulumi.BoolPtrInput
+	StrictTags         pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -97,6 +118,10 @@ func (o ProviderOutput) Host() pulumi.StringOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringOutput { return v.Host }).(pulumi.StringOutput)
 }
 
+func (o ProviderOutput) StrictTags() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.StrictTags }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
@@ -13,6 +13,379 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type AssetCollectionItem struct {
+	Description        *string                     `pulumi:"description"`
+	Environments       map[string]AssetEnvironment `pulumi:"environments"`
+	ExternalLinks      []ExternalLink              `pulumi:"externalLinks"`
+	IgnoreMetadataKeys []string                    `pulumi:"ignoreMetadataKeys"`
+	Metadata           map[string]interface{}      `pulumi:"metadata"`
+	Name               string                      `pulumi:"name"`
+	Schema             map[string]interface{}      `pulumi:"schema"`
+	Services           []string                    `pulumi:"services"`
+	Sources            []AssetSource               `pulumi:"sources"`
+	Tags               []string                    `pulumi:"tags"`
+	Type               string                      `pulumi:"type"`
+}
+
+// AssetCollectionItemInput is an input type that accepts AssetCollectionItemArgs and AssetCollectionItemOutput values.
+// You can construct a concrete instance of `AssetCollectionItemInput` via:
+//
+//	AssetCollectionItemArgs{...}
+type AssetCollectionItemInput interface {
+	pulumi.Input
+
+	ToAssetCollectionItemOutput() AssetCollectionItemOutput
+	ToAssetCollectionItemOutputWithContext(context.Context) AssetCollectionItemOutput
+}
+
+type AssetCollectionItemArgs struct {
+	Description        pulumi.StringPtrInput    `pulumi:"description"`
+	Environments       AssetEnvironmentMapInput `pulumi:"environments"`
+	ExternalLinks      ExternalLinkArrayInput   `pulumi:"externalLinks"`
+	IgnoreMetadataKeys pulumi.StringArrayInput  `pulumi:"ignoreMetadataKeys"`
+	Metadata           pulumi.MapInput          `pulumi:"metadata"`
+	Name               pulumi.StringInput       `pulumi:"name"`
+	Schema             pulumi.MapInput          `pulumi:"schema"`
+	Services           pulumi.StringArrayInput  `pulumi:"services"`
+	Sources            AssetSourceArrayInput    `pulumi:"sources"`
+	Tags               pulumi.StringArrayInput  `pulumi:"tags"`
+	Type               pulumi.StringInput       `pulumi:"type"`
+}
+
+func (AssetCollectionItemArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetCollectionItem)(nil)).Elem()
+}
+
+func (i AssetCollectionItemArgs) ToAssetCollectionItemOutput() AssetCollectionItemOutput {
+	return i.ToAssetCollectionItemOutputWithContext(context.Background())
+}
+
+func (i AssetCollectionItemArgs) ToAssetCollectionItemOutputWithContext(ctx context.Context) AssetCollectionItemOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionItemOutput)
+}
+
+func (i AssetCollectionItemArgs) ToAssetCollectionItemPtrOutput() AssetCollectionItemPtrOutput {
+	return i.ToAssetCollectionItemPtrOutputWithContext(context.Background())
+}
+
+func (i AssetCollectionItemArgs) ToAssetCollectionItemPtrOutputWithContext(ctx context.Context) AssetCollectionItemPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionItemOutput).ToAssetCollectionItemPtrOutputWithContext(ctx)
+}
+
+// AssetCollectionItemPtrInput is an input type that accepts AssetCollectionItemArgs, AssetCollectionItemPtr and AssetCollectionItemPtrOutput values.
+// You can construct a concrete instance of `AssetCollectionItemPtrInput` via:
+//
+//	        AssetCollectionItemArgs{...}
+//
+//	or:
+//
+//	        nil
+type AssetCollectionItemPtrInput interface {
+	pulumi.Input
+
+	ToAssetCollectionItemPtrOutput() AssetCollectionItemPtrOutput
+	ToAssetCollectionItemPtrOutputWithContext(context.Context) AssetCollectionItemPtrOutput
+}
+
+type assetCollectionItemPtrType AssetCollectionItemArgs
+
+func AssetCollectionItemPtr(v *AssetCollectionItemArgs) AssetCollectionItemPtrInput {
+	return (*assetCollectionItemPtrType)(v)
+}
+
+func (*assetCollectionItemPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AssetCollectionItem)(nil)).Elem()
+}
+
+func (i *assetCollectionItemPtrType) ToAssetCollectionItemPtrOutput() AssetCollectionItemPtrOutput {
+	return i.ToAssetCollectionItemPtrOutputWithContext(context.Background())
+}
+
+func (i *assetCollectionItemPtrType) ToAssetCollectionItemPtrOutputWithContext(ctx context.Context) AssetCollectionItemPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionItemPtrOutput)
+}
+
+// AssetCollectionItemArrayInput is an input type that accepts AssetCollectionItemArray and AssetCollectionItemArrayOutput values.
+// You can construct a concrete instance of `AssetCollectionItemArrayInput` via:
+//
+//	AssetCollectionItemArray{ AssetCollectionItemArgs{...} }
+type AssetCollectionItemArrayInput interface {
+	pulumi.Input
+
+	ToAssetCollectionItemArrayOutput() AssetCollectionItemArrayOutput
+	ToAssetCollectionItemArrayOutputWithContext(context.Context) AssetCollectionItemArrayOutput
+}
+
+type AssetCollectionItemArray []AssetCollectionItemInput
+
+func (AssetCollectionItemArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssetCollectionItem)(nil)).Elem()
+}
+
+func (i AssetCollectionItemArray) ToAssetCollectionItemArrayOutput() AssetCollectionItemArrayOutput {
+	return i.ToAssetCollectionItemArrayOutputWithContext(context.Background())
+}
+
+func (i AssetCollectionItemArray) ToAssetCollectionItemArrayOutputWithContext(ctx context.Context) AssetCollectionItemArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionItemArrayOutput)
+}
+
+// AssetCollectionItemMapInput is an input type that accepts AssetCollectionItemMap and AssetCollectionItemMapOutput values.
+// You can construct a concrete instance of `AssetCollectionItemMapInput` via:
+//
+//	AssetCollectionItemMap{ "key": AssetCollectionItemArgs{...} }
+type AssetCollectionItemMapInput interface {
+	pulumi.Input
+
+	ToAssetCollectionItemMapOutput() AssetCollectionItemMapOutput
+	ToAssetCollectionItemMapOutputWithContext(context.Context) AssetCollectionItemMapOutput
+}
+
+type AssetCollectionItemMap map[string]AssetCollectionItemInput
+
+func (AssetCollectionItemMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]AssetCollectionItem)(nil)).Elem()
+}
+
+func (i AssetCollectionItemMap) ToAssetCollectionItemMapOutput() AssetCollectionItemMapOutput {
+	return i.ToAssetCollectionItemMapOutputWithContext(context.Background())
+}
+
+func (i AssetCollectionItemMap) ToAssetCollectionItemMapOutputWithContext(ctx context.Context) AssetCollectionItemMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetCollectionItemMapOutput)
+}
+
+type AssetCollectionItemOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionItemOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetCollectionItem)(nil)).Elem()
+}
+
+func (o AssetCollectionItemOutput) ToAssetCollectionItemOutput() AssetCollectionItemOutput {
+	return o
+}
+
+func (o AssetCollectionItemOutput) ToAssetCollectionItemOutputWithContext(ctx context.Context) AssetCollectionItemOutput {
+	return o
+}
+
+func (o AssetCollectionItemOutput) ToAssetCollectionItemPtrOutput() AssetCollectionItemPtrOutput {
+	return o.ToAssetCollectionItemPtrOutputWithContext(context.Background())
+}
+
+func (o AssetCollectionItemOutput) ToAssetCollectionItemPtrOutputWithContext(ctx context.Context) AssetCollectionItemPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AssetCollectionItem) *AssetCollectionItem {
+		return &v
+	}).(AssetCollectionItemPtrOutput)
+}
+
+func (o AssetCollectionItemOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AssetCollectionItem) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+func (o AssetCollectionItemOutput) Environments() AssetEnvironmentMapOutput {
+	return o.ApplyT(func(v AssetCollectionItem) map[string]AssetEnvironment { return v.Environments }).(AssetEnvironmentMapOutput)
+}
+
+func (o AssetCollectionItemOutput) ExternalLinks() ExternalLinkArrayOutput {
+	return o.ApplyT(func(v AssetCollectionItem) []ExternalLink { return v.ExternalLinks }).(ExternalLinkArrayOutput)
+}
+
+func (o AssetCollectionItemOutput) IgnoreMetadataKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssetCollectionItem) []string { return v.IgnoreMetadataKeys }).(pulumi.StringArrayOutput)
+}
+
+func (o AssetCollectionItemOutput) Metadata() pulumi.MapOutput {
+	return o.ApplyT(func(v AssetCollectionItem) map[string]interface{} { return v.Metadata }).(pulumi.MapOutput)
+}
+
+func (o AssetCollectionItemOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetCollectionItem) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o AssetCollectionItemOutput) Schema() pulumi.MapOutput {
+	return o.ApplyT(func(v AssetCollectionItem) map[string]interface{} { return v.Schema }).(pulumi.MapOutput)
+}
+
+func (o AssetCollectionItemOutput) Services() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssetCollectionItem) []string { return v.Services }).(pulumi.StringArrayOutput)
+}
+
+func (o AssetCollectionItemOutput) Sources() AssetSourceArrayOutput {
+	return o.ApplyT(func(v AssetCollectionItem) []AssetSource { return v.Sources }).(AssetSourceArrayOutput)
+}
+
+func (o AssetCollectionItemOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssetCollectionItem) []string { return v.Tags }).(pulumi.StringArrayOutput)
+}
+
+func (o AssetCollectionItemOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetCollectionItem) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type AssetCollectionItemPtrOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionItemPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AssetCollectionItem)(nil)).Elem()
+}
+
+func (o AssetCollectionItemPtrOutput) ToAssetCollectionItemPtrOutput() AssetCollectionItemPtrOutput {
+	return o
+}
+
+func (o AssetCollectionItemPtrOutput) ToAssetCollectionItemPtrOutputWithContext(ctx context.Context) AssetCollectionItemPtrOutput {
+	return o
+}
+
+func (o AssetCollectionItemPtrOutput) Elem() AssetCollectionItemOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) AssetCollectionItem {
+		if v != nil {
+			return *v
+		}
+		var ret AssetCollectionItem
+		return ret
+	}).(AssetCollectionItemOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Description
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Environments() AssetEnvironmentMapOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) map[string]AssetEnvironment {
+		if v == nil {
+			return nil
+		}
+		return v.Environments
+	}).(AssetEnvironmentMapOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) ExternalLinks() ExternalLinkArrayOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) []ExternalLink {
+		if v == nil {
+			return nil
+		}
+		return v.ExternalLinks
+	}).(ExternalLinkArrayOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) IgnoreMetadataKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) []string {
+		if v == nil {
+			return nil
+		}
+		return v.IgnoreMetadataKeys
+	}).(pulumi.StringArrayOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Metadata() pulumi.MapOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Metadata
+	}).(pulumi.MapOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Name
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Schema() pulumi.MapOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Schema
+	}).(pulumi.MapOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Services() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Services
+	}).(pulumi.StringArrayOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Sources() AssetSourceArrayOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) []AssetSource {
+		if v == nil {
+			return nil
+		}
+		return v.Sources
+	}).(AssetSourceArrayOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Tags
+	}).(pulumi.StringArrayOutput)
+}
+
+func (o AssetCollectionItemPtrOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AssetCollectionItem) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Type
+	}).(pulumi.StringPtrOutput)
+}
+
+type AssetCollectionItemArrayOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionItemArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssetCollectionItem)(nil)).Elem()
+}
+
+func (o AssetCollectionItemArrayOutput) ToAssetCollectionItemArrayOutput() AssetCollectionItemArrayOutput {
+	return o
+}
+
+func (o AssetCollectionItemArrayOutput) ToAssetCollectionItemArrayOutputWithContext(ctx context.Context) AssetCollectionItemArrayOutput {
+	return o
+}
+
+func (o AssetCollectionItemArrayOutput) Index(i pulumi.IntInput) AssetCollectionItemOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AssetCollectionItem {
+		return vs[0].([]AssetCollectionItem)[vs[1].(int)]
+	}).(AssetCollectionItemOutput)
+}
+
+type AssetCollectionItemMapOutput struct{ *pulumi.OutputState }
+
+func (AssetCollectionItemMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]AssetCollectionItem)(nil)).Elem()
+}
+
+func (o AssetCollectionItemMapOutput) ToAssetCollectionItemMapOutput() AssetCollectionItemMapOutput {
+	return o
+}
+
+func (o AssetCollectionItemMapOutput) ToAssetCollectionItemMapOutputWithContext(ctx context.Context) AssetCollectionItemMapOutput {
+	return o
+}
+
+func (o AssetCollectionItemMapOutput) MapIndex(k pulumi.StringInput) AssetCollectionItemOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) AssetCollectionItem {
+		return vs[0].(map[string]AssetCollectionItem)[vs[1].(string)]
+	}).(AssetCollectionItemOutput)
+}
+
 type AssetEnvironment struct {
 	Metadata map[string]interface{} `pulumi:"metadata"`
 	Name     string                 `pulumi:"name"`
@@ -119,6 +492,173 @@ func (o AssetEnvironmentMapOutput) MapIndex(k pulumi.StringInput) AssetEnvironme
 	}).(AssetEnvironmentOutput)
 }
 
+type AssetFilters struct {
+	Services map[string]int `pulumi:"services"`
+	Tags     map[string]int `pulumi:"tags"`
+	Types    map[string]int `pulumi:"types"`
+}
+
+// AssetFiltersInput is an input type that accepts AssetFiltersArgs and AssetFiltersOutput values.
+// You can construct a concrete instance of `AssetFiltersInput` via:
+//
+//	AssetFiltersArgs{...}
+type AssetFiltersInput interface {
+	pulumi.Input
+
+	ToAssetFiltersOutput() AssetFiltersOutput
+	ToAssetFiltersOutputWithContext(context.Context) AssetFiltersOutput
+}
+
+type AssetFiltersArgs struct {
+	Services pulumi.IntMapInput `pulumi:"services"`
+	Tags     pulumi.IntMapInput `pulumi:"tags"`
+	Types    pulumi.IntMapInput `pulumi:"types"`
+}
+
+func (AssetFiltersArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetFilters)(nil)).Elem()
+}
+
+func (i AssetFiltersArgs) ToAssetFiltersOutput() AssetFiltersOutput {
+	return i.ToAssetFiltersOutputWithContext(context.Background())
+}
+
+func (i AssetFiltersArgs) ToAssetFiltersOutputWithContext(ctx context.Context) AssetFiltersOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetFiltersOutput)
+}
+
+type AssetFiltersOutput struct{ *pulumi.OutputState }
+
+func (AssetFiltersOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetFilters)(nil)).Elem()
+}
+
+func (o AssetFiltersOutput) ToAssetFiltersOutput() AssetFiltersOutput {
+	return o
+}
+
+func (o AssetFiltersOutput) ToAssetFiltersOutputWithContext(ctx context.Context) AssetFiltersOutput {
+	return o
+}
+
+func (o AssetFiltersOutput) Services() pulumi.IntMapOutput {
+	return o.ApplyT(func(v AssetFilters) map[string]int { return v.Services }).(pulumi.IntMapOutput)
+}
+
+func (o AssetFiltersOutput) Tags() pulumi.IntMapOutput {
+	return o.ApplyT(func(v AssetFilters) map[string]int { return v.Tags }).(pulumi.IntMapOutput)
+}
+
+func (o AssetFiltersOutput) Types() pulumi.IntMapOutput {
+	return o.ApplyT(func(v AssetFilters) map[string]int { return v.Types }).(pulumi.IntMapOutput)
+}
+
+type AssetMatch struct {
+	Mrn        string `pulumi:"mrn"`
+	Name       string `pulumi:"name"`
+	ResourceId string `pulumi:"resourceId"`
+}
+
+// AssetMatchInput is an input type that accepts AssetMatchArgs and AssetMatchOutput values.
+// You can construct a concrete instance of `AssetMatchInput` via:
+//
+//	AssetMatchArgs{...}
+type AssetMatchInput interface {
+	pulumi.Input
+
+	ToAssetMatchOutput() AssetMatchOutput
+	ToAssetMatchOutputWithContext(context.Context) AssetMatchOutput
+}
+
+type AssetMatchArgs struct {
+	Mrn        pulumi.StringInput `pulumi:"mrn"`
+	Name       pulumi.StringInput `pulumi:"name"`
+	ResourceId pulumi.StringInput `pulumi:"resourceId"`
+}
+
+func (AssetMatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetMatch)(nil)).Elem()
+}
+
+func (i AssetMatchArgs) ToAssetMatchOutput() AssetMatchOutput {
+	return i.ToAssetMatchOutputWithContext(context.Background())
+}
+
+func (i AssetMatchArgs) ToAssetMatchOutputWithContext(ctx context.Context) AssetMatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetMatchOutput)
+}
+
+// AssetMatchArrayInput is an input type that accepts AssetMatchArray and AssetMatchArrayOutput values.
+// You can construct a concrete instance of `AssetMatchArrayInput` via:
+//
+//	AssetMatchArray{ AssetMatchArgs{...} }
+type AssetMatchArrayInput interface {
+	pulumi.Input
+
+	ToAssetMatchArrayOutput() AssetMatchArrayOutput
+	ToAssetMatchArrayOutputWithContext(context.Context) AssetMatchArrayOutput
+}
+
+type AssetMatchArray []AssetMatchInput
+
+func (AssetMatchArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssetMatch)(nil)).Elem()
+}
+
+func (i AssetMatchArray) ToAssetMatchArrayOutput() AssetMatchArrayOutput {
+	return i.ToAssetMatchArrayOutputWithContext(context.Background())
+}
+
+func (i AssetMatchArray) ToAssetMatchArrayOutputWithContext(ctx context.Context) AssetMatchArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetMatchArrayOutput)
+}
+
+type AssetMatchOutput struct{ *pulumi.OutputState }
+
+func (AssetMatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetMatch)(nil)).Elem()
+}
+
+func (o AssetMatchOutput) ToAssetMatchOutput() AssetMatchOutput {
+	return o
+}
+
+func (o AssetMatchOutput) ToAssetMatchOutputWithContext(ctx context.Context) AssetMatchOutput {
+	return o
+}
+
+func (o AssetMatchOutput) Mrn() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetMatch) string { return v.Mrn }).(pulumi.StringOutput)
+}
+
+func (o AssetMatchOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetMatch) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o AssetMatchOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetMatch) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+type AssetMatchArrayOutput struct{ *pulumi.OutputState }
+
+func (AssetMatchArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssetMatch)(nil)).Elem()
+}
+
+func (o AssetMatchArrayOutput) ToAssetMatchArrayOutput() AssetMatchArrayOutput {
+	return o
+}
+
+func (o AssetMatchArrayOutput) ToAssetMatchArrayOutputWithContext(ctx context.Context) AssetMatchArrayOutput {
+	return o
+}
+
+func (o AssetMatchArrayOutput) Index(i pulumi.IntInput) AssetMatchOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AssetMatch {
+		return vs[0].([]AssetMatch)[vs[1].(int)]
+	}).(AssetMatchOutput)
+}
+
 type AssetSource struct {
 	Name       string                 `pulumi:"name"`
 	Priority   *int                   `pulumi:"priority"`
@@ -225,123 +765,2019 @@ func (o AssetSourceArrayOutput) Index(i pulumi.IntInput) AssetSourceOutput {
 	}).(AssetSourceOutput)
 }
 
-type ExternalLink struct {
-	Icon *string `pulumi:"icon"`
-	Name string  `pulumi:"name"`
-	Url  string  `pulumi:"url"`
+type AssetSummary struct {
+	Mrn        string   `pulumi:"mrn"`
+	Name       string   `pulumi:"name"`
+	ParentMrn  *string  `pulumi:"parentMrn"`
+	ResourceId string   `pulumi:"resourceId"`
+	Services   []string `pulumi:"services"`
+	Tags       []string `pulumi:"tags"`
+	Type       string   `pulumi:"type"`
+	UpdatedAt  string   `pulumi:"updatedAt"`
 }
 
-// ExternalLinkInput is an input type that accepts ExternalLinkArgs and ExternalLinkOutput values.
-// You can construct a concrete instance of `ExternalLinkInput` via:
+// AssetSummaryInput is an input type that accepts AssetSummaryArgs and AssetSummaryOutput values.
+// You can construct a concrete instance of `AssetSummaryInput` via:
 //
-//	ExternalLinkArgs{...}
-type ExternalLinkInput interface {
+//	AssetSummaryArgs{...}
+type AssetSummaryInput interface {
 	pulumi.Input
 
-	ToExternalLinkOutput() ExternalLinkOutput
-	ToExternalLinkOutputWithContext(context.Context) ExternalLinkOutput
+	ToAssetSummaryOutput() AssetSummaryOutput
+	ToAssetSummaryOutputWithContext(context.Context) AssetSummaryOutput
 }
 
-type ExternalLinkArgs struct {
-	Icon pulumi.StringPtrInput `pulumi:"icon"`
-	Name pulumi.StringInput    `pulumi:"name"`
-	Url  pulumi.StringInput    `pulumi:"url"`
+type AssetSummaryArgs struct {
+	Mrn        pulumi.StringInput      `pulumi:"mrn"`
+	Name       pulumi.StringInput      `pulumi:"name"`
+	ParentMrn  pulumi.StringPtrInput   `pulumi:"parentMrn"`
+	ResourceId pulumi.StringInput      `pulumi:"resourceId"`
+	Services   pulumi.StringArrayInput `pulumi:"services"`
+	Tags       pulumi.StringArrayInput `pulumi:"tags"`
+	Type       pulumi.StringInput      `pulumi:"type"`
+	UpdatedAt  pulumi.StringInput      `pulumi:"updatedAt"`
 }
 
-func (ExternalLinkArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*ExternalLink)(nil)).Elem()
+func (AssetSummaryArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetSummary)(nil)).Elem()
 }
 
-func (i ExternalLinkArgs) ToExternalLinkOutput() ExternalLinkOutput {
-	return i.ToExternalLinkOutputWithContext(context.Background())
+func (i AssetSummaryArgs) ToAssetSummaryOutput() AssetSummaryOutput {
+	return i.ToAssetSummaryOutputWithContext(context.Background())
 }
 
-func (i ExternalLinkArgs) ToExternalLinkOutputWithContext(ctx context.Context) ExternalLinkOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ExternalLinkOutput)
+func (i AssetSummaryArgs) ToAssetSummaryOutputWithContext(ctx context.Context) AssetSummaryOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetSummaryOutput)
 }
 
-// ExternalLinkArrayInput is an input type that accepts ExternalLinkArray and ExternalLinkArrayOutput values.
-// You can construct a concrete instance of `ExternalLinkArrayInput` via:
+// AssetSummaryArrayInput is an input type that accepts AssetSummaryArray and AssetSummaryArrayOutput values.
+// You can construct a concrete instance of `AssetSummaryArrayInput` via:
 //
-//	ExternalLinkArray{ ExternalLinkArgs{...} }
+//	AssetSummaryArray{ AssetSummaryArgs{...} }
+type AssetSummaryArrayInput interface {
+	pulumi.Input
+
+	ToAssetSummaryArrayOutput() AssetSummaryArrayOutput
+	ToAssetSummaryArrayOutputWithContext(context.Context) AssetSummaryArrayOutput
+}
+
+type AssetSummaryArray []AssetSummaryInput
+
+func (AssetSummaryArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssetSummary)(nil)).Elem()
+}
+
+func (i AssetSummaryArray) ToAssetSummaryArrayOutput() AssetSummaryArrayOutput {
+	return i.ToAssetSummaryArrayOutputWithContext(context.Background())
+}
+
+func (i AssetSummaryArray) ToAssetSummaryArrayOutputWithContext(ctx context.Context) AssetSummaryArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AssetSummaryArrayOutput)
+}
+
+type AssetSummaryOutput struct{ *pulumi.OutputState }
+
+func (AssetSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AssetSummary)(nil)).Elem()
+}
+
+func (o AssetSummaryOutput) ToAssetSummaryOutput() AssetSummaryOutput {
+	return o
+}
+
+func (o AssetSummaryOutput) ToAssetSummaryOutputWithContext(ctx context.Context) AssetSummaryOutput {
+	return o
+}
+
+func (o AssetSummaryOutput) Mrn() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetSummary) string { return v.Mrn }).(pulumi.StringOutput)
+}
+
+func (o AssetSummaryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetSummary) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o AssetSummaryOutput) ParentMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AssetSummary) *string { return v.ParentMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o AssetSummaryOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetSummary) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o AssetSummaryOutput) Services() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssetSummary) []string { return v.Services }).(pulumi.StringArrayOutput)
+}
+
+func (o AssetSummaryOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v AssetSummary) []string { return v.Tags }).(pulumi.StringArrayOutput)
+}
+
+func (o AssetSummaryOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetSummary) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o AssetSummaryOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v AssetSummary) string { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+type AssetSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (AssetSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AssetSummary)(nil)).Elem()
+}
+
+func (o AssetSummaryArrayOutput) ToAssetSummaryArrayOutput() AssetSummaryArrayOutput {
+	return o
+}
+
+func (o AssetSummaryArrayOutput) ToAssetSummaryArrayOutputWithContext(ctx context.Context) AssetSummaryArrayOutput {
+	return o
+}
+
+func (o AssetSummaryArrayOutput) Index(i pulumi.IntInput) AssetSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AssetSummary {
+		return vs[0].([]AssetSummary)[vs[1].(int)]
+	}).(AssetSummaryOutput)
+}
+
+type CatalogAsset struct {
+	CreatedAt     string                      `pulumi:"createdAt"`
+	CreatedBy     string                      `pulumi:"createdBy"`
+	Description   *string                     `pulumi:"description"`
+	Environments  map[string]AssetEnvironment `pulumi:"environments"`
+	ExternalLinks []ExternalLink              `pulumi:"externalLinks"`
+	LastSyncAt    *string                     `pulumi:"lastSyncAt"`
+	Metadata      map[string]interface{}      `pulumi:"metadata"`
+	Mrn           string                      `pulumi:"mrn"`
+	Name          string                      `pulumi:"name"`
+	ParentMrn     *string                     `pulumi:"parentMrn"`
+	ResourceId    string                      `pulumi:"resourceId"`
+	Schema        map[string]interface{}      `pulumi:"schema"`
+	Services      []string                    `pulumi:"services"`
+	Sources       []AssetSource               `pulumi:"sources"`
+	Tags          []string                    `pulumi:"tags"`
+	Type          string                      `pulumi:"type"`
+	UpdatedAt     string                      `pulumi:"updatedAt"`
+}
+
+// CatalogAssetInput is an input type that accepts CatalogAssetArgs and CatalogAssetOutput values.
+// You can construct a concrete instance of `CatalogAssetInput` via:
+//
+//	CatalogAssetArgs{...}
+type CatalogAssetInput interface {
+	pulumi.Input
+
+	ToCatalogAssetOutput() CatalogAssetOutput
+	ToCatalogAssetOutputWithContext(context.Context) CatalogAssetOutput
+}
+
+type CatalogAssetArgs struct {
+	CreatedAt     pulumi.StringInput       `pulumi:"createdAt"`
+	CreatedBy     pulumi.StringInput       `pulumi:"createdBy"`
+	Description   pulumi.StringPtrInput    `pulumi:"description"`
+	Environments  AssetEnvironmentMapInput `pulumi:"environments"`
+	ExternalLinks ExternalLinkArrayInput   `pulumi:"externalLinks"`
+	LastSyncAt    pulumi.StringPtrInput    `pulumi:"lastSyncAt"`
+	Metadata      pulumi.MapInput          `pulumi:"metadata"`
+	Mrn           pulumi.StringInput       `pulumi:"mrn"`
+	Name          pulumi.StringInput       `pulumi:"name"`
+	ParentMrn     pulumi.StringPtrInput    `pulumi:"parentMrn"`
+	ResourceId    pulumi.StringInput       `pulumi:"resourceId"`
+	Schema        pulumi.MapInput          `pulumi:"schema"`
+	Services      pulumi.StringArrayInput  `pulumi:"services"`
+	Sources       AssetSourceArrayInput    `pulumi:"sources"`
+	Tags          pulumi.StringArrayInput  `pulumi:"tags"`
+	Type          pulumi.StringInput       `pulumi:"type"`
+	UpdatedAt     pulumi.StringInput       `pulumi:"updatedAt"`
+}
+
+func (CatalogAssetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CatalogAsset)(nil)).Elem()
+}
+
+func (i CatalogAssetArgs) ToCatalogAssetOutput() CatalogAssetOutput {
+	return i.ToCatalogAssetOutputWithContext(context.Background())
+}
+
+func (i CatalogAssetArgs) ToCatalogAssetOutputWithContext(ctx context.Context) CatalogAssetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CatalogAssetOutput)
+}
+
+func (i CatalogAssetArgs) ToCatalogAssetPtrOutput() CatalogAssetPtrOutput {
+	return i.ToCatalogAssetPtrOutputWithContext(context.Background())
+}
+
+func (i CatalogAssetArgs) ToCatalogAssetPtrOutputWithContext(ctx context.Context) CatalogAssetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CatalogAssetOutput).ToCatalogAssetPtrOutputWithContext(ctx)
+}
+
+// CatalogAssetPtrInput is an input type that accepts CatalogAssetArgs, CatalogAssetPtr and CatalogAssetPtrOutput values.
+// You can construct a concrete instance of `CatalogAssetPtrInput` via:
+//
+//	        CatalogAssetArgs{...}
+//
+//	or:
+//
+//	        nil
+type CatalogAssetPtrInput interface {
+	pulumi.Input
+
+	ToCatalogAssetPtrOutput() CatalogAssetPtrOutput
+	ToCatalogAssetPtrOutputWithContext(context.Context) CatalogAssetPtrOutput
+}
+
+type catalogAssetPtrType CatalogAssetArgs
+
+func CatalogAssetPtr(v *CatalogAssetArgs) CatalogAssetPtrInput {
+	return (*catalogAssetPtrType)(v)
+}
+
+func (*catalogAssetPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CatalogAsset)(nil)).Elem()
+}
+
+func (i *catalogAssetPtrType) ToCatalogAssetPtrOutput() CatalogAssetPtrOutput {
+	return i.ToCatalogAssetPtrOutputWithContext(context.Background())
+}
+
+func (i *catalogAssetPtrType) ToCatalogAssetPtrOutputWithContext(ctx context.Context) CatalogAssetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CatalogAssetPtrOutput)
+}
+
+// CatalogAssetArrayInput is an input type that accepts CatalogAssetArray and CatalogAssetArrayOutput values.
+// You can construct a concrete instance of `CatalogAssetArrayInput` via:
+//
+//	CatalogAssetArray{ CatalogAssetArgs{...} }
+type CatalogAssetArrayInput interface {
+	pulumi.Input
+
+	ToCatalogAssetArrayOutput() CatalogAssetArrayOutput
+	ToCatalogAssetArrayOutputWithContext(context.Context) CatalogAssetArrayOutput
+}
+
+type CatalogAssetArray []CatalogAssetInput
+
+func (CatalogAssetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CatalogAsset)(nil)).Elem()
+}
+
+func (i CatalogAssetArray) ToCatalogAssetArrayOutput() CatalogAssetArrayOutput {
+	return i.ToCatalogAssetArrayOutputWithContext(context.Background())
+}
+
+func (i CatalogAssetArray) ToCatalogAssetArrayOutputWithContext(ctx context.Context) CatalogAssetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CatalogAssetArrayOutput)
+}
+
+type CatalogAssetOutput struct{ *pulumi.OutputState }
+
+func (CatalogAssetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CatalogAsset)(nil)).Elem()
+}
+
+func (o CatalogAssetOutput) ToCatalogAssetOutput() CatalogAssetOutput {
+	return o
+}
+
+func (o CatalogAssetOutput) ToCatalogAssetOutputWithContext(ctx context.Context) CatalogAssetOutput {
+	return o
+}
+
+func (o CatalogAssetOutput) ToCatalogAssetPtrOutput() CatalogAssetPtrOutput {
+	return o.ToCatalogAssetPtrOutputWithContext(context.Background())
+}
+
+func (o CatalogAssetOutput) ToCatalogAssetPtrOutputWithContext(ctx context.Context) CatalogAssetPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CatalogAsset) *CatalogAsset {
+		return &v
+	}).(CatalogAssetPtrOutput)
+}
+
+func (o CatalogAssetOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+func (o CatalogAssetOutput) CreatedBy() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.CreatedBy }).(pulumi.StringOutput)
+}
+
+func (o CatalogAssetOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CatalogAsset) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetOutput) Environments() AssetEnvironmentMapOutput {
+	return o.ApplyT(func(v CatalogAsset) map[string]AssetEnvironment { return v.Environments }).(AssetEnvironmentMapOutput)
+}
+
+func (o CatalogAssetOutput) ExternalLinks() ExternalLinkArrayOutput {
+	return o.ApplyT(func(v CatalogAsset) []ExternalLink { return v.ExternalLinks }).(ExternalLinkArrayOutput)
+}
+
+func (o CatalogAssetOutput) LastSyncAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CatalogAsset) *string { return v.LastSyncAt }).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetOutput) Metadata() pulumi.MapOutput {
+	return o.ApplyT(func(v CatalogAsset) map[string]interface{} { return v.Metadata }).(pulumi.MapOutput)
+}
+
+func (o CatalogAssetOutput) Mrn() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.Mrn }).(pulumi.StringOutput)
+}
+
+func (o CatalogAssetOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o CatalogAssetOutput) ParentMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CatalogAsset) *string { return v.ParentMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o CatalogAssetOutput) Schema() pulumi.MapOutput {
+	return o.ApplyT(func(v CatalogAsset) map[string]interface{} { return v.Schema }).(pulumi.MapOutput)
+}
+
+func (o CatalogAssetOutput) Services() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CatalogAsset) []string { return v.Services }).(pulumi.StringArrayOutput)
+}
+
+func (o CatalogAssetOutput) Sources() AssetSourceArrayOutput {
+	return o.ApplyT(func(v CatalogAsset) []AssetSource { return v.Sources }).(AssetSourceArrayOutput)
+}
+
+func (o CatalogAssetOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CatalogAsset) []string { return v.Tags }).(pulumi.StringArrayOutput)
+}
+
+func (o CatalogAssetOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o CatalogAssetOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v CatalogAsset) string { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+type CatalogAssetPtrOutput struct{ *pulumi.OutputState }
+
+func (CatalogAssetPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CatalogAsset)(nil)).Elem()
+}
+
+func (o CatalogAssetPtrOutput) ToCatalogAssetPtrOutput() CatalogAssetPtrOutput {
+	return o
+}
+
+func (o CatalogAssetPtrOutput) ToCatalogAssetPtrOutputWithContext(ctx context.Context) CatalogAssetPtrOutput {
+	return o
+}
+
+func (o CatalogAssetPtrOutput) Elem() CatalogAssetOutput {
+	return o.ApplyT(func(v *CatalogAsset) CatalogAsset {
+		if v != nil {
+			return *v
+		}
+		var ret CatalogAsset
+		return ret
+	}).(CatalogAssetOutput)
+}
+
+func (o CatalogAssetPtrOutput) CreatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.CreatedAt
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) CreatedBy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.CreatedBy
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Description
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) Environments() AssetEnvironmentMapOutput {
+	return o.ApplyT(func(v *CatalogAsset) map[string]AssetEnvironment {
+		if v == nil {
+			return nil
+		}
+		return v.Environments
+	}).(AssetEnvironmentMapOutput)
+}
+
+func (o CatalogAssetPtrOutput) ExternalLinks() ExternalLinkArrayOutput {
+	return o.ApplyT(func(v *CatalogAsset) []ExternalLink {
+		if v == nil {
+			return nil
+		}
+		return v.ExternalLinks
+	}).(ExternalLinkArrayOutput)
+}
+
+func (o CatalogAssetPtrOutput) LastSyncAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return v.LastSyncAt
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) Metadata() pulumi.MapOutput {
+	return o.ApplyT(func(v *CatalogAsset) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Metadata
+	}).(pulumi.MapOutput)
+}
+
+func (o CatalogAssetPtrOutput) Mrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Mrn
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Name
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) ParentMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ParentMrn
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) ResourceId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ResourceId
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) Schema() pulumi.MapOutput {
+	return o.ApplyT(func(v *CatalogAsset) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.Schema
+	}).(pulumi.MapOutput)
+}
+
+func (o CatalogAssetPtrOutput) Services() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *CatalogAsset) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Services
+	}).(pulumi.StringArrayOutput)
+}
+
+func (o CatalogAssetPtrOutput) Sources() AssetSourceArrayOutput {
+	return o.ApplyT(func(v *CatalogAsset) []AssetSource {
+		if v == nil {
+			return nil
+		}
+		return v.Sources
+	}).(AssetSourceArrayOutput)
+}
+
+func (o CatalogAssetPtrOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *CatalogAsset) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Tags
+	}).(pulumi.StringArrayOutput)
+}
+
+func (o CatalogAssetPtrOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Type
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o CatalogAssetPtrOutput) UpdatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CatalogAsset) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.UpdatedAt
+	}).(pulumi.StringPtrOutput)
+}
+
+type CatalogAssetArrayOutput struct{ *pulumi.OutputState }
+
+func (CatalogAssetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CatalogAsset)(nil)).Elem()
+}
+
+func (o CatalogAssetArrayOutput) ToCatalogAssetArrayOutput() CatalogAssetArrayOutput {
+	return o
+}
+
+func (o CatalogAssetArrayOutput) ToCatalogAssetArrayOutputWithContext(ctx context.Context) CatalogAssetArrayOutput {
+	return o
+}
+
+func (o CatalogAssetArrayOutput) Index(i pulumi.IntInput) CatalogAssetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CatalogAsset {
+		return vs[0].([]CatalogAsset)[vs[1].(int)]
+	}).(CatalogAssetOutput)
+}
+
+type DocumentResult struct {
+	Error  *string `pulumi:"error"`
+	Hash   string  `pulumi:"hash"`
+	Mrn    string  `pulumi:"mrn"`
+	Status string  `pulumi:"status"`
+}
+
+// DocumentResultInput is an input type that accepts DocumentResultArgs and DocumentResultOutput values.
+// You can construct a concrete instance of `DocumentResultInput` via:
+//
+//	DocumentResultArgs{...}
+type DocumentResultInput interface {
+	pulumi.Input
+
+	ToDocumentResultOutput() DocumentResultOutput
+	ToDocumentResultOutputWithContext(context.Context) DocumentResultOutput
+}
+
+type DocumentResultArgs struct {
+	Error  pulumi.StringPtrInput `pulumi:"error"`
+	Hash   pulumi.StringInput    `pulumi:"hash"`
+	Mrn    pulumi.StringInput    `pulumi:"mrn"`
+	Status pulumi.StringInput    `pulumi:"status"`
+}
+
+func (DocumentResultArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DocumentResult)(nil)).Elem()
+}
+
+func (i DocumentResultArgs) ToDocumentResultOutput() DocumentResultOutput {
+	return i.ToDocumentResultOutputWithContext(context.Background())
+}
+
+func (i DocumentResultArgs) ToDocumentResultOutputWithContext(ctx context.Context) DocumentResultOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DocumentResultOutput)
+}
+
+// DocumentResultMapInput is an input type that accepts DocumentResultMap and DocumentResultMapOutput values.
+// You can construct a concrete instance of `DocumentResultMapInput` via:
+//
+//	DocumentResultMap{ "key": DocumentResultArgs{...} }
+type DocumentResultMapInput interface {
+	pulumi.Input
+
+	ToDocumentResultMapOutput() DocumentResultMapOutput
+	ToDocumentResultMapOutputWithContext(context.Context) DocumentResultMapOutput
+}
+
+type DocumentResultMap map[string]DocumentResultInput
+
+func (DocumentResultMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]DocumentResult)(nil)).Elem()
+}
+
+func (i DocumentResultMap) ToDocumentResultMapOutput() DocumentResultMapOutput {
+	return i.ToDocumentResultMapOutputWithContext(context.Background())
+}
+
+func (i DocumentResultMap) ToDocumentResultMapOutputWithContext(ctx context.Context) DocumentResultMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DocumentResultMapOutput)
+}
+
+type DocumentResultOutput struct{ *pulumi.OutputState }
+
+func (DocumentResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DocumentResult)(nil)).Elem()
+}
+
+func (o DocumentResultOutput) ToDocumentResultOutput() DocumentResultOutput {
+	return o
+}
+
+func (o DocumentResultOutput) ToDocumentResultOutputWithContext(ctx context.Context) DocumentResultOutput {
+	return o
+}
+
+func (o DocumentResultOutput) Error() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DocumentResult) *string { return v.Error }).(pulumi.StringPtrOutput)
+}
+
+func (o DocumentResultOutput) Hash() pulumi.StringOutput {
+	return o.ApplyT(func(v DocumentResult) string { return v.Hash }).(pulumi.StringOutput)
+}
+
+func (o DocumentResultOutput) Mrn() pulumi.StringOutput {
+	return o.ApplyT(func(v DocumentResult) string { return v.Mrn }).(pulumi.StringOutput)
+}
+
+func (o DocumentResultOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v DocumentResult) string { return v.Status }).(pulumi.StringOutput)
+}
+
+type DocumentResultMapOutput struct{ *pulumi.OutputState }
+
+func (DocumentResultMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]DocumentResult)(nil)).Elem()
+}
+
+func (o DocumentResultMapOutput) ToDocumentResultMapOutput() DocumentResultMapOutput {
+	return o
+}
+
+func (o DocumentResultMapOutput) ToDocumentResultMapOutputWithContext(ctx context.Context) DocumentResultMapOutput {
+	return o
+}
+
+func (o DocumentResultMapOutput) MapIndex(k pulumi.StringInput) DocumentResultOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) DocumentResult {
+		return vs[0].(map[string]DocumentResult)[vs[1].(string)]
+	}).(DocumentResultOutput)
+}
+
+type ExternalLink struct {
+	Icon *string `pulumi:"icon"`
+	Name string  `pulumi:"name"`
+	Url  string  `pulumi:"url"`
+}
+
+// ExternalLinkInput is an input type that accepts ExternalLinkArgs and ExternalLinkOutput values.
+// You can construct a concrete instance of `ExternalLinkInput` via:
+//
+//	ExternalLinkArgs{...}
+type ExternalLinkInput interface {
+	pulumi.Input
+
+	ToExternalLinkOutput() ExternalLinkOutput
+	ToExternalLinkOutputWithContext(context.Context) ExternalLinkOutput
+}
+
+type ExternalLinkArgs struct {
+	Icon pulumi.StringPtrInput `pulumi:"icon"`
+	Name pulumi.StringInput    `pulumi:"name"`
+	Url  pulumi.StringInput    `pulumi:"url"`
+}
+
+func (ExternalLinkArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExternalLink)(nil)).Elem()
+}
+
+func (i ExternalLinkArgs) ToExternalLinkOutput() ExternalLinkOutput {
+	return i.ToExternalLinkOutputWithContext(context.Background())
+}
+
+func (i ExternalLinkArgs) ToExternalLinkOutputWithContext(ctx context.Context) ExternalLinkOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExternalLinkOutput)
+}
+
+// ExternalLinkArrayInput is an input type that accepts ExternalLinkArray and ExternalLinkArrayOutput values.
+// You can construct a concrete instance of `ExternalLinkArrayInput` via:
+//
+//	ExternalLinkArray{ ExternalLinkArgs{...} }
 type ExternalLinkArrayInput interface {
 	pulumi.Input
 
-	ToExternalLinkArrayOutput() ExternalLinkArrayOutput
-	ToExternalLinkArrayOutputWithContext(context.Context) ExternalLinkArrayOutput
+	ToExternalLinkArrayOutput() ExternalLinkArrayOutput
+	ToExternalLinkArrayOutputWithContext(context.Context) ExternalLinkArrayOutput
+}
+
+type ExternalLinkArray []ExternalLinkInput
+
+func (ExternalLinkArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ExternalLink)(nil)).Elem()
+}
+
+func (i ExternalLinkArray) ToExternalLinkArrayOutput() ExternalLinkArrayOutput {
+	return i.ToExternalLinkArrayOutputWithContext(context.Background())
+}
+
+func (i ExternalLinkArray) ToExternalLinkArrayOutputWithContext(ctx context.Context) ExternalLinkArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExternalLinkArrayOutput)
+}
+
+type ExternalLinkOutput struct{ *pulumi.OutputState }
+
+func (ExternalLinkOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExternalLink)(nil)).Elem()
+}
+
+func (o ExternalLinkOutput) ToExternalLinkOutput() ExternalLinkOutput {
+	return o
+}
+
+func (o ExternalLinkOutput) ToExternalLinkOutputWithContext(ctx context.Context) ExternalLinkOutput {
+	return o
+}
+
+func (o ExternalLinkOutput) Icon() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ExternalLink) *string { return v.Icon }).(pulumi.StringPtrOutput)
+}
+
+func (o ExternalLinkOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ExternalLink) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o ExternalLinkOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v ExternalLink) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type ExternalLinkArrayOutput struct{ *pulumi.OutputState }
+
+func (ExternalLinkArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ExternalLink)(nil)).Elem()
+}
+
+func (o ExternalLinkArrayOutput) ToExternalLinkArrayOutput() ExternalLinkArrayOutput {
+	return o
+}
+
+func (o ExternalLinkArrayOutput) ToExternalLinkArrayOutputWithContext(ctx context.Context) ExternalLinkArrayOutput {
+	return o
+}
+
+func (o ExternalLinkArrayOutput) Index(i pulumi.IntInput) ExternalLinkOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ExternalLink {
+		return vs[0].([]ExternalLink)[vs[1].(int)]
+	}).(ExternalLinkOutput)
+}
+
+type LineageEdge struct {
+	JobMrn     *string `pulumi:"jobMrn"`
+	ResourceId string  `pulumi:"resourceId"`
+	Source     string  `pulumi:"source"`
+	Target     string  `pulumi:"target"`
+	Type       *string `pulumi:"type"`
+}
+
+// LineageEdgeInput is an input type that accepts LineageEdgeArgs and LineageEdgeOutput values.
+// You can construct a concrete instance of `LineageEdgeInput` via:
+//
+//	LineageEdgeArgs{...}
+type LineageEdgeInput interface {
+	pulumi.Input
+
+	ToLineageEdgeOutput() LineageEdgeOutput
+	ToLineageEdgeOutputWithContext(context.Context) LineageEdgeOutput
+}
+
+type LineageEdgeArgs struct {
+	JobMrn     pulumi.StringPtrInput `pulumi:"jobMrn"`
+	ResourceId pulumi.StringInput    `pulumi:"resourceId"`
+	Source     pulumi.StringInput    `pulumi:"source"`
+	Target     pulumi.StringInput    `pulumi:"target"`
+	Type       pulumi.StringPtrInput `pulumi:"type"`
+}
+
+func (LineageEdgeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageEdge)(nil)).Elem()
+}
+
+func (i LineageEdgeArgs) ToLineageEdgeOutput() LineageEdgeOutput {
+	return i.ToLineageEdgeOutputWithContext(context.Background())
+}
+
+func (i LineageEdgeArgs) ToLineageEdgeOutputWithContext(ctx context.Context) LineageEdgeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageEdgeOutput)
+}
+
+// LineageEdgeArrayInput is an input type that accepts LineageEdgeArray and LineageEdgeArrayOutput values.
+// You can construct a concrete instance of `LineageEdgeArrayInput` via:
+//
+//	LineageEdgeArray{ LineageEdgeArgs{...} }
+type LineageEdgeArrayInput interface {
+	pulumi.Input
+
+	ToLineageEdgeArrayOutput() LineageEdgeArrayOutput
+	ToLineageEdgeArrayOutputWithContext(context.Context) LineageEdgeArrayOutput
+}
+
+type LineageEdgeArray []LineageEdgeInput
+
+func (LineageEdgeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageEdge)(nil)).Elem()
+}
+
+func (i LineageEdgeArray) ToLineageEdgeArrayOutput() LineageEdgeArrayOutput {
+	return i.ToLineageEdgeArrayOutputWithContext(context.Background())
+}
+
+func (i LineageEdgeArray) ToLineageEdgeArrayOutputWithContext(ctx context.Context) LineageEdgeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageEdgeArrayOutput)
+}
+
+type LineageEdgeOutput struct{ *pulumi.OutputState }
+
+func (LineageEdgeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageEdge)(nil)).Elem()
+}
+
+func (o LineageEdgeOutput) ToLineageEdgeOutput() LineageEdgeOutput {
+	return o
+}
+
+func (o LineageEdgeOutput) ToLineageEdgeOutputWithContext(ctx context.Context) LineageEdgeOutput {
+	return o
+}
+
+func (o LineageEdgeOutput) JobMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LineageEdge) *string { return v.JobMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o LineageEdgeOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageEdge) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o LineageEdgeOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageEdge) string { return v.Source }).(pulumi.StringOutput)
+}
+
+func (o LineageEdgeOutput) Target() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageEdge) string { return v.Target }).(pulumi.StringOutput)
+}
+
+func (o LineageEdgeOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LineageEdge) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type LineageEdgeArrayOutput struct{ *pulumi.OutputState }
+
+func (LineageEdgeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageEdge)(nil)).Elem()
+}
+
+func (o LineageEdgeArrayOutput) ToLineageEdgeArrayOutput() LineageEdgeArrayOutput {
+	return o
+}
+
+func (o LineageEdgeArrayOutput) ToLineageEdgeArrayOutputWithContext(ctx context.Context) LineageEdgeArrayOutput {
+	return o
+}
+
+func (o LineageEdgeArrayOutput) Index(i pulumi.IntInput) LineageEdgeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LineageEdge {
+		return vs[0].([]LineageEdge)[vs[1].(int)]
+	}).(LineageEdgeOutput)
+}
+
+type LineageNode struct {
+	Asset      *CatalogAsset `pulumi:"asset"`
+	Depth      int           `pulumi:"depth"`
+	ResourceId string        `pulumi:"resourceId"`
+	Type       string        `pulumi:"type"`
+}
+
+// LineageNodeInput is an input type that accepts LineageNodeArgs and LineageNodeOutput values.
+// You can construct a concrete instance of `LineageNodeInput` via:
+//
+//	LineageNodeArgs{...}
+type LineageNodeInput interface {
+	pulumi.Input
+
+	ToLineageNodeOutput() LineageNodeOutput
+	ToLineageNodeOutputWithContext(context.Context) LineageNodeOutput
+}
+
+type LineageNodeArgs struct {
+	Asset      CatalogAssetPtrInput `pulumi:"asset"`
+	Depth      pulumi.IntInput      `pulumi:"depth"`
+	ResourceId pulumi.StringInput   `pulumi:"resourceId"`
+	Type       pulumi.StringInput   `pulumi:"type"`
+}
+
+func (LineageNodeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageNode)(nil)).Elem()
+}
+
+func (i LineageNodeArgs) ToLineageNodeOutput() LineageNodeOutput {
+	return i.ToLineageNodeOutputWithContext(context.Background())
+}
+
+func (i LineageNodeArgs) ToLineageNodeOutputWithContext(ctx context.Context) LineageNodeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageNodeOutput)
+}
+
+// LineageNodeArrayInput is an input type that accepts LineageNodeArray and LineageNodeArrayOutput values.
+// You can construct a concrete instance of `LineageNodeArrayInput` via:
+//
+//	LineageNodeArray{ LineageNodeArgs{...} }
+type LineageNodeArrayInput interface {
+	pulumi.Input
+
+	ToLineageNodeArrayOutput() LineageNodeArrayOutput
+	ToLineageNodeArrayOutputWithContext(context.Context) LineageNodeArrayOutput
+}
+
+type LineageNodeArray []LineageNodeInput
+
+func (LineageNodeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageNode)(nil)).Elem()
+}
+
+func (i LineageNodeArray) ToLineageNodeArrayOutput() LineageNodeArrayOutput {
+	return i.ToLineageNodeArrayOutputWithContext(context.Background())
+}
+
+func (i LineageNodeArray) ToLineageNodeArrayOutputWithContext(ctx context.Context) LineageNodeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageNodeArrayOutput)
+}
+
+type LineageNodeOutput struct{ *pulumi.OutputState }
+
+func (LineageNodeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageNode)(nil)).Elem()
+}
+
+func (o LineageNodeOutput) ToLineageNodeOutput() LineageNodeOutput {
+	return o
+}
+
+func (o LineageNodeOutput) ToLineageNodeOutputWithContext(ctx context.Context) LineageNodeOutput {
+	return o
+}
+
+func (o LineageNodeOutput) Asset() CatalogAssetPtrOutput {
+	return o.ApplyT(func(v LineageNode) *CatalogAsset { return v.Asset }).(CatalogAssetPtrOutput)
+}
+
+func (o LineageNodeOutput) Depth() pulumi.IntOutput {
+	return o.ApplyT(func(v LineageNode) int { return v.Depth }).(pulumi.IntOutput)
+}
+
+func (o LineageNodeOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageNode) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o LineageNodeOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageNode) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type LineageNodeArrayOutput struct{ *pulumi.OutputState }
+
+func (LineageNodeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageNode)(nil)).Elem()
+}
+
+func (o LineageNodeArrayOutput) ToLineageNodeArrayOutput() LineageNodeArrayOutput {
+	return o
+}
+
+func (o LineageNodeArrayOutput) ToLineageNodeArrayOutputWithContext(ctx context.Context) LineageNodeArrayOutput {
+	return o
+}
+
+func (o LineageNodeArrayOutput) Index(i pulumi.IntInput) LineageNodeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LineageNode {
+		return vs[0].([]LineageNode)[vs[1].(int)]
+	}).(LineageNodeOutput)
+}
+
+type LineageSetEdge struct {
+	JobMrn *string `pulumi:"jobMrn"`
+	Source string  `pulumi:"source"`
+	Target string  `pulumi:"target"`
+}
+
+// LineageSetEdgeInput is an input type that accepts LineageSetEdgeArgs and LineageSetEdgeOutput values.
+// You can construct a concrete instance of `LineageSetEdgeInput` via:
+//
+//	LineageSetEdgeArgs{...}
+type LineageSetEdgeInput interface {
+	pulumi.Input
+
+	ToLineageSetEdgeOutput() LineageSetEdgeOutput
+	ToLineageSetEdgeOutputWithContext(context.Context) LineageSetEdgeOutput
+}
+
+type LineageSetEdgeArgs struct {
+	JobMrn pulumi.StringPtrInput `pulumi:"jobMrn"`
+	Source pulumi.StringInput    `pulumi:"source"`
+	Target pulumi.StringInput    `pulumi:"target"`
+}
+
+func (LineageSetEdgeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageSetEdge)(nil)).Elem()
+}
+
+func (i LineageSetEdgeArgs) ToLineageSetEdgeOutput() LineageSetEdgeOutput {
+	return i.ToLineageSetEdgeOutputWithContext(context.Background())
+}
+
+func (i LineageSetEdgeArgs) ToLineageSetEdgeOutputWithContext(ctx context.Context) LineageSetEdgeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetEdgeOutput)
+}
+
+// LineageSetEdgeArrayInput is an input type that accepts LineageSetEdgeArray and LineageSetEdgeArrayOutput values.
+// You can construct a concrete instance of `LineageSetEdgeArrayInput` via:
+//
+//	LineageSetEdgeArray{ LineageSetEdgeArgs{...} }
+type LineageSetEdgeArrayInput interface {
+	pulumi.Input
+
+	ToLineageSetEdgeArrayOutput() LineageSetEdgeArrayOutput
+	ToLineageSetEdgeArrayOutputWithContext(context.Context) LineageSetEdgeArrayOutput
+}
+
+type LineageSetEdgeArray []LineageSetEdgeInput
+
+func (LineageSetEdgeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageSetEdge)(nil)).Elem()
+}
+
+func (i LineageSetEdgeArray) ToLineageSetEdgeArrayOutput() LineageSetEdgeArrayOutput {
+	return i.ToLineageSetEdgeArrayOutputWithContext(context.Background())
+}
+
+func (i LineageSetEdgeArray) ToLineageSetEdgeArrayOutputWithContext(ctx context.Context) LineageSetEdgeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetEdgeArrayOutput)
+}
+
+type LineageSetEdgeOutput struct{ *pulumi.OutputState }
+
+func (LineageSetEdgeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageSetEdge)(nil)).Elem()
+}
+
+func (o LineageSetEdgeOutput) ToLineageSetEdgeOutput() LineageSetEdgeOutput {
+	return o
+}
+
+func (o LineageSetEdgeOutput) ToLineageSetEdgeOutputWithContext(ctx context.Context) LineageSetEdgeOutput {
+	return o
+}
+
+func (o LineageSetEdgeOutput) JobMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LineageSetEdge) *string { return v.JobMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o LineageSetEdgeOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageSetEdge) string { return v.Source }).(pulumi.StringOutput)
+}
+
+func (o LineageSetEdgeOutput) Target() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageSetEdge) string { return v.Target }).(pulumi.StringOutput)
+}
+
+type LineageSetEdgeArrayOutput struct{ *pulumi.OutputState }
+
+func (LineageSetEdgeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageSetEdge)(nil)).Elem()
+}
+
+func (o LineageSetEdgeArrayOutput) ToLineageSetEdgeArrayOutput() LineageSetEdgeArrayOutput {
+	return o
+}
+
+func (o LineageSetEdgeArrayOutput) ToLineageSetEdgeArrayOutputWithContext(ctx context.Context) LineageSetEdgeArrayOutput {
+	return o
+}
+
+func (o LineageSetEdgeArrayOutput) Index(i pulumi.IntInput) LineageSetEdgeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LineageSetEdge {
+		return vs[0].([]LineageSetEdge)[vs[1].(int)]
+	}).(LineageSetEdgeOutput)
+}
+
+type LineageSetEdgeResult struct {
+	JobMrn     *string `pulumi:"jobMrn"`
+	ResourceId string  `pulumi:"resourceId"`
+	Source     string  `pulumi:"source"`
+	Status     string  `pulumi:"status"`
+	Target     string  `pulumi:"target"`
+	Type       *string `pulumi:"type"`
+}
+
+// LineageSetEdgeResultInput is an input type that accepts LineageSetEdgeResultArgs and LineageSetEdgeResultOutput values.
+// You can construct a concrete instance of `LineageSetEdgeResultInput` via:
+//
+//	LineageSetEdgeResultArgs{...}
+type LineageSetEdgeResultInput interface {
+	pulumi.Input
+
+	ToLineageSetEdgeResultOutput() LineageSetEdgeResultOutput
+	ToLineageSetEdgeResultOutputWithContext(context.Context) LineageSetEdgeResultOutput
+}
+
+type LineageSetEdgeResultArgs struct {
+	JobMrn     pulumi.StringPtrInput `pulumi:"jobMrn"`
+	ResourceId pulumi.StringInput    `pulumi:"resourceId"`
+	Source     pulumi.StringInput    `pulumi:"source"`
+	Status     pulumi.StringInput    `pulumi:"status"`
+	Target     pulumi.StringInput    `pulumi:"target"`
+	Type       pulumi.StringPtrInput `pulumi:"type"`
+}
+
+func (LineageSetEdgeResultArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageSetEdgeResult)(nil)).Elem()
+}
+
+func (i LineageSetEdgeResultArgs) ToLineageSetEdgeResultOutput() LineageSetEdgeResultOutput {
+	return i.ToLineageSetEdgeResultOutputWithContext(context.Background())
+}
+
+func (i LineageSetEdgeResultArgs) ToLineageSetEdgeResultOutputWithContext(ctx context.Context) LineageSetEdgeResultOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetEdgeResultOutput)
+}
+
+// LineageSetEdgeResultArrayInput is an input type that accepts LineageSetEdgeResultArray and LineageSetEdgeResultArrayOutput values.
+// You can construct a concrete instance of `LineageSetEdgeResultArrayInput` via:
+//
+//	LineageSetEdgeResultArray{ LineageSetEdgeResultArgs{...} }
+type LineageSetEdgeResultArrayInput interface {
+	pulumi.Input
+
+	ToLineageSetEdgeResultArrayOutput() LineageSetEdgeResultArrayOutput
+	ToLineageSetEdgeResultArrayOutputWithContext(context.Context) LineageSetEdgeResultArrayOutput
+}
+
+type LineageSetEdgeResultArray []LineageSetEdgeResultInput
+
+func (LineageSetEdgeResultArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageSetEdgeResult)(nil)).Elem()
+}
+
+func (i LineageSetEdgeResultArray) ToLineageSetEdgeResultArrayOutput() LineageSetEdgeResultArrayOutput {
+	return i.ToLineageSetEdgeResultArrayOutputWithContext(context.Background())
+}
+
+func (i LineageSetEdgeResultArray) ToLineageSetEdgeResultArrayOutputWithContext(ctx context.Context) LineageSetEdgeResultArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LineageSetEdgeResultArrayOutput)
+}
+
+type LineageSetEdgeResultOutput struct{ *pulumi.OutputState }
+
+func (LineageSetEdgeResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LineageSetEdgeResult)(nil)).Elem()
+}
+
+func (o LineageSetEdgeResultOutput) ToLineageSetEdgeResultOutput() LineageSetEdgeResultOutput {
+	return o
+}
+
+func (o LineageSetEdgeResultOutput) ToLineageSetEdgeResultOutputWithContext(ctx context.Context) LineageSetEdgeResultOutput {
+	return o
+}
+
+func (o LineageSetEdgeResultOutput) JobMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LineageSetEdgeResult) *string { return v.JobMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o LineageSetEdgeResultOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageSetEdgeResult) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o LineageSetEdgeResultOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageSetEdgeResult) string { return v.Source }).(pulumi.StringOutput)
+}
+
+func (o LineageSetEdgeResultOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageSetEdgeResult) string { return v.Status }).(pulumi.StringOutput)
+}
+
+func (o LineageSetEdgeResultOutput) Target() pulumi.StringOutput {
+	return o.ApplyT(func(v LineageSetEdgeResult) string { return v.Target }).(pulumi.StringOutput)
+}
+
+func (o LineageSetEdgeResultOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LineageSetEdgeResult) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type LineageSetEdgeResultArrayOutput struct{ *pulumi.OutputState }
+
+func (LineageSetEdgeResultArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LineageSetEdgeResult)(nil)).Elem()
+}
+
+func (o LineageSetEdgeResultArrayOutput) ToLineageSetEdgeResultArrayOutput() LineageSetEdgeResultArrayOutput {
+	return o
+}
+
+func (o LineageSetEdgeResultArrayOutput) ToLineageSetEdgeResultArrayOutputWithContext(ctx context.Context) LineageSetEdgeResultArrayOutput {
+	return o
+}
+
+func (o LineageSetEdgeResultArrayOutput) Index(i pulumi.IntInput) LineageSetEdgeResultOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LineageSetEdgeResult {
+		return vs[0].([]LineageSetEdgeResult)[vs[1].(int)]
+	}).(LineageSetEdgeResultOutput)
+}
+
+type ManagedAsset struct {
+	Id   string `pulumi:"id"`
+	Mrn  string `pulumi:"mrn"`
+	Name string `pulumi:"name"`
+	Type string `pulumi:"type"`
+	Urn  string `pulumi:"urn"`
+}
+
+// ManagedAssetInput is an input type that accepts ManagedAssetArgs and ManagedAssetOutput values.
+// You can construct a concrete instance of `ManagedAssetInput` via:
+//
+//	ManagedAssetArgs{...}
+type ManagedAssetInput interface {
+	pulumi.Input
+
+	ToManagedAssetOutput() ManagedAssetOutput
+	ToManagedAssetOutputWithContext(context.Context) ManagedAssetOutput
+}
+
+type ManagedAssetArgs struct {
+	Id   pulumi.StringInput `pulumi:"id"`
+	Mrn  pulumi.StringInput `pulumi:"mrn"`
+	Name pulumi.StringInput `pulumi:"name"`
+	Type pulumi.StringInput `pulumi:"type"`
+	Urn  pulumi.StringInput `pulumi:"urn"`
+}
+
+func (ManagedAssetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ManagedAsset)(nil)).Elem()
+}
+
+func (i ManagedAssetArgs) ToManagedAssetOutput() ManagedAssetOutput {
+	return i.ToManagedAssetOutputWithContext(context.Background())
+}
+
+func (i ManagedAssetArgs) ToManagedAssetOutputWithContext(ctx context.Context) ManagedAssetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ManagedAssetOutput)
+}
+
+// ManagedAssetArrayInput is an input type that accepts ManagedAssetArray and ManagedAssetArrayOutput values.
+// You can construct a concrete instance of `ManagedAssetArrayInput` via:
+//
+//	ManagedAssetArray{ ManagedAssetArgs{...} }
+type ManagedAssetArrayInput interface {
+	pulumi.Input
+
+	ToManagedAssetArrayOutput() ManagedAssetArrayOutput
+	ToManagedAssetArrayOutputWithContext(context.Context) ManagedAssetArrayOutput
+}
+
+type ManagedAssetArray []ManagedAssetInput
+
+func (ManagedAssetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ManagedAsset)(nil)).Elem()
+}
+
+func (i ManagedAssetArray) ToManagedAssetArrayOutput() ManagedAssetArrayOutput {
+	return i.ToManagedAssetArrayOutputWithContext(context.Background())
+}
+
+func (i ManagedAssetArray) ToManagedAssetArrayOutputWithContext(ctx context.Context) ManagedAssetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ManagedAssetArrayOutput)
+}
+
+type ManagedAssetOutput struct{ *pulumi.OutputState }
+
+func (ManagedAssetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ManagedAsset)(nil)).Elem()
+}
+
+func (o ManagedAssetOutput) ToManagedAssetOutput() ManagedAssetOutput {
+	return o
+}
+
+func (o ManagedAssetOutput) ToManagedAssetOutputWithContext(ctx context.Context) ManagedAssetOutput {
+	return o
+}
+
+func (o ManagedAssetOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ManagedAsset) string { return v.Id }).(pulumi.StringOutput)
+}
+
+func (o ManagedAssetOutput) Mrn() pulumi.StringOutput {
+	return o.ApplyT(func(v ManagedAsset) string { return v.Mrn }).(pulumi.StringOutput)
+}
+
+func (o ManagedAssetOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ManagedAsset) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o ManagedAssetOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v ManagedAsset) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o ManagedAssetOutput) Urn() pulumi.StringOutput {
+	return o.ApplyT(func(v ManagedAsset) string { return v.Urn }).(pulumi.StringOutput)
+}
+
+type ManagedAssetArrayOutput struct{ *pulumi.OutputState }
+
+func (ManagedAssetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ManagedAsset)(nil)).Elem()
+}
+
+func (o ManagedAssetArrayOutput) ToManagedAssetArrayOutput() ManagedAssetArrayOutput {
+	return o
+}
+
+func (o ManagedAssetArrayOutput) ToManagedAssetArrayOutputWithContext(ctx context.Context) ManagedAssetArrayOutput {
+	return o
+}
+
+func (o ManagedAssetArrayOutput) Index(i pulumi.IntInput) ManagedAssetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ManagedAsset {
+		return vs[0].([]ManagedAsset)[vs[1].(int)]
+	}).(ManagedAssetOutput)
+}
+
+type MetadataFieldSuggestion struct {
+	Count     int         `pulumi:"count"`
+	Example   interface{} `pulumi:"example"`
+	Field     string      `pulumi:"field"`
+	PathParts []string    `pulumi:"pathParts"`
+	Type      string      `pulumi:"type"`
+	Types     []string    `pulumi:"types"`
+}
+
+// MetadataFieldSuggestionInput is an input type that accepts MetadataFieldSuggestionArgs and MetadataFieldSuggestionOutput values.
+// You can construct a concrete instance of `MetadataFieldSuggestionInput` via:
+//
+//	MetadataFieldSuggestionArgs{...}
+type MetadataFieldSuggestionInput interface {
+	pulumi.Input
+
+	ToMetadataFieldSuggestionOutput() MetadataFieldSuggestionOutput
+	ToMetadataFieldSuggestionOutputWithContext(context.Context) MetadataFieldSuggestionOutput
+}
+
+type MetadataFieldSuggestionArgs struct {
+	Count     pulumi.IntInput         `pulumi:"count"`
+	Example   pulumi.Input            `pulumi:"example"`
+	Field     pulumi.StringInput      `pulumi:"field"`
+	PathParts pulumi.StringArrayInput `pulumi:"pathParts"`
+	Type      pulumi.StringInput      `pulumi:"type"`
+	Types     pulumi.StringArrayInput `pulumi:"types"`
+}
+
+func (MetadataFieldSuggestionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetadataFieldSuggestion)(nil)).Elem()
+}
+
+func (i MetadataFieldSuggestionArgs) ToMetadataFieldSuggestionOutput() MetadataFieldSuggestionOutput {
+	return i.ToMetadataFieldSuggestionOutputWithContext(context.Background())
+}
+
+func (i MetadataFieldSuggestionArgs) ToMetadataFieldSuggestionOutputWithContext(ctx context.Context) MetadataFieldSuggestionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetadataFieldSuggestionOutput)
+}
+
+// MetadataFieldSuggestionArrayInput is an input type that accepts MetadataFieldSuggestionArray and MetadataFieldSuggestionArrayOutput values.
+// You can construct a concrete instance of `MetadataFieldSuggestionArrayInput` via:
+//
+//	MetadataFieldSuggestionArray{ MetadataFieldSuggestionArgs{...} }
+type MetadataFieldSuggestionArrayInput interface {
+	pulumi.Input
+
+	ToMetadataFieldSuggestionArrayOutput() MetadataFieldSuggestionArrayOutput
+	ToMetadataFieldSuggestionArrayOutputWithContext(context.Context) MetadataFieldSuggestionArrayOutput
+}
+
+type MetadataFieldSuggestionArray []MetadataFieldSuggestionInput
+
+func (MetadataFieldSuggestionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetadataFieldSuggestion)(nil)).Elem()
+}
+
+func (i MetadataFieldSuggestionArray) ToMetadataFieldSuggestionArrayOutput() MetadataFieldSuggestionArrayOutput {
+	return i.ToMetadataFieldSuggestionArrayOutputWithContext(context.Background())
+}
+
+func (i MetadataFieldSuggestionArray) ToMetadataFieldSuggestionArrayOutputWithContext(ctx context.Context) MetadataFieldSuggestionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetadataFieldSuggestionArrayOutput)
+}
+
+type MetadataFieldSuggestionOutput struct{ *pulumi.OutputState }
+
+func (MetadataFieldSuggestionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetadataFieldSuggestion)(nil)).Elem()
+}
+
+func (o MetadataFieldSuggestionOutput) ToMetadataFieldSuggestionOutput() MetadataFieldSuggestionOutput {
+	return o
+}
+
+func (o MetadataFieldSuggestionOutput) ToMetadataFieldSuggestionOutputWithContext(ctx context.Context) MetadataFieldSuggestionOutput {
+	return o
+}
+
+func (o MetadataFieldSuggestionOutput) Count() pulumi.IntOutput {
+	return o.ApplyT(func(v MetadataFieldSuggestion) int { return v.Count }).(pulumi.IntOutput)
+}
+
+func (o MetadataFieldSuggestionOutput) Example() pulumi.AnyOutput {
+	return o.ApplyT(func(v MetadataFieldSuggestion) interface{} { return v.Example }).(pulumi.AnyOutput)
+}
+
+func (o MetadataFieldSuggestionOutput) Field() pulumi.StringOutput {
+	return o.ApplyT(func(v MetadataFieldSuggestion) string { return v.Field }).(pulumi.StringOutput)
+}
+
+func (o MetadataFieldSuggestionOutput) PathParts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v MetadataFieldSuggestion) []string { return v.PathParts }).(pulumi.StringArrayOutput)
+}
+
+func (o MetadataFieldSuggestionOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v MetadataFieldSuggestion) string { return v.Type }).(pulumi.StringOutput)
+}
+
+func (o MetadataFieldSuggestionOutput) Types() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v MetadataFieldSuggestion) []string { return v.Types }).(pulumi.StringArrayOutput)
+}
+
+type MetadataFieldSuggestionArrayOutput struct{ *pulumi.OutputState }
+
+func (MetadataFieldSuggestionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetadataFieldSuggestion)(nil)).Elem()
+}
+
+func (o MetadataFieldSuggestionArrayOutput) ToMetadataFieldSuggestionArrayOutput() MetadataFieldSuggestionArrayOutput {
+	return o
+}
+
+func (o MetadataFieldSuggestionArrayOutput) ToMetadataFieldSuggestionArrayOutputWithContext(ctx context.Context) MetadataFieldSuggestionArrayOutput {
+	return o
+}
+
+func (o MetadataFieldSuggestionArrayOutput) Index(i pulumi.IntInput) MetadataFieldSuggestionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MetadataFieldSuggestion {
+		return vs[0].([]MetadataFieldSuggestion)[vs[1].(int)]
+	}).(MetadataFieldSuggestionOutput)
+}
+
+type MetadataValueSuggestion struct {
+	Count      int     `pulumi:"count"`
+	ExampleMrn *string `pulumi:"exampleMrn"`
+	Value      string  `pulumi:"value"`
+}
+
+// MetadataValueSuggestionInput is an input type that accepts MetadataValueSuggestionArgs and MetadataValueSuggestionOutput values.
+// You can construct a concrete instance of `MetadataValueSuggestionInput` via:
+//
+//	MetadataValueSuggestionArgs{...}
+type MetadataValueSuggestionInput interface {
+	pulumi.Input
+
+	ToMetadataValueSuggestionOutput() MetadataValueSuggestionOutput
+	ToMetadataValueSuggestionOutputWithContext(context.Context) MetadataValueSuggestionOutput
 }
 
-type ExternalLinkArray []ExternalLinkInput
+type MetadataValueSuggestionArgs struct {
+	Count      pulumi.IntInput       `pulumi:"count"`
+	ExampleMrn pulumi.StringPtrInput `pulumi:"exampleMrn"`
+	Value      pulumi.StringInput    `pulumi:"value"`
+}
 
-func (ExternalLinkArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ExternalLink)(nil)).Elem()
+func (MetadataValueSuggestionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetadataValueSuggestion)(nil)).Elem()
 }
 
-func (i ExternalLinkArray) ToExternalLinkArrayOutput() ExternalLinkArrayOutput {
-	return i.ToExternalLinkArrayOutputWithContext(context.Background())
+func (i MetadataValueSuggestionArgs) ToMetadataValueSuggestionOutput() MetadataValueSuggestionOutput {
+	return i.ToMetadataValueSuggestionOutputWithContext(context.Background())
 }
 
-func (i ExternalLinkArray) ToExternalLinkArrayOutputWithContext(ctx context.Context) ExternalLinkArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ExternalLinkArrayOutput)
+func (i MetadataValueSuggestionArgs) ToMetadataValueSuggestionOutputWithContext(ctx context.Context) MetadataValueSuggestionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetadataValueSuggestionOutput)
 }
 
-type ExternalLinkOutput struct{ *pulumi.OutputState }
+// MetadataValueSuggestionArrayInput is an input type that accepts MetadataValueSuggestionArray and MetadataValueSuggestionArrayOutput values.
+// You can construct a concrete instance of `MetadataValueSuggestionArrayInput` via:
+//
+//	MetadataValueSuggestionArray{ MetadataValueSuggestionArgs{...} }
+type MetadataValueSuggestionArrayInput interface {
+	pulumi.Input
 
-func (ExternalLinkOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*ExternalLink)(nil)).Elem()
+	ToMetadataValueSuggestionArrayOutput() MetadataValueSuggestionArrayOutput
+	ToMetadataValueSuggestionArrayOutputWithContext(context.Context) MetadataValueSuggestionArrayOutput
 }
 
-func (o ExternalLinkOutput) ToExternalLinkOutput() ExternalLinkOutput {
+type MetadataValueSuggestionArray []MetadataValueSuggestionInput
+
+func (MetadataValueSuggestionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetadataValueSuggestion)(nil)).Elem()
+}
+
+func (i MetadataValueSuggestionArray) ToMetadataValueSuggestionArrayOutput() MetadataValueSuggestionArrayOutput {
+	return i.ToMetadataValueSuggestionArrayOutputWithContext(context.Background())
+}
+
+func (i MetadataValueSuggestionArray) ToMetadataValueSuggestionArrayOutputWithContext(ctx context.Context) MetadataValueSuggestionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MetadataValueSuggestionArrayOutput)
+}
+
+type MetadataValueSuggestionOutput struct{ *pulumi.OutputState }
+
+func (MetadataValueSuggestionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MetadataValueSuggestion)(nil)).Elem()
+}
+
+func (o MetadataValueSuggestionOutput) ToMetadataValueSuggestionOutput() MetadataValueSuggestionOutput {
 	return o
 }
 
-func (o ExternalLinkOutput) ToExternalLinkOutputWithContext(ctx context.Context) ExternalLinkOutput {
+func (o MetadataValueSuggestionOutput) ToMetadataValueSuggestionOutputWithContext(ctx context.Context) MetadataValueSuggestionOutput {
 	return o
 }
 
-func (o ExternalLinkOutput) Icon() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ExternalLink) *string { return v.Icon }).(pulumi.StringPtrOutput)
+func (o MetadataValueSuggestionOutput) Count() pulumi.IntOutput {
+	return o.ApplyT(func(v MetadataValueSuggestion) int { return v.Count }).(pulumi.IntOutput)
 }
 
-func (o ExternalLinkOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v ExternalLink) string { return v.Name }).(pulumi.StringOutput)
+func (o MetadataValueSuggestionOutput) ExampleMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v MetadataValueSuggestion) *string { return v.ExampleMrn }).(pulumi.StringPtrOutput)
 }
 
-func (o ExternalLinkOutput) Url() pulumi.StringOutput {
-	return o.ApplyT(func(v ExternalLink) string { return v.Url }).(pulumi.StringOutput)
+func (o MetadataValueSuggestionOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v MetadataValueSuggestion) string { return v.Value }).(pulumi.StringOutput)
 }
 
-type ExternalLinkArrayOutput struct{ *pulumi.OutputState }
+type MetadataValueSuggestionArrayOutput struct{ *pulumi.OutputState }
 
-func (ExternalLinkArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ExternalLink)(nil)).Elem()
+func (MetadataValueSuggestionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MetadataValueSuggestion)(nil)).Elem()
 }
 
-func (o ExternalLinkArrayOutput) ToExternalLinkArrayOutput() ExternalLinkArrayOutput {
+func (o MetadataValueSuggestionArrayOutput) ToMetadataValueSuggestionArrayOutput() MetadataValueSuggestionArrayOutput {
 	return o
 }
 
-func (o ExternalLinkArrayOutput) ToExternalLinkArrayOutputWithContext(ctx context.Context) ExternalLinkArrayOutput {
+func (o MetadataValueSuggestionArrayOutput) ToMetadataValueSuggestionArrayOutputWithContext(ctx context.Context) MetadataValueSuggestionArrayOutput {
 	return o
 }
 
-func (o ExternalLinkArrayOutput) Index(i pulumi.IntInput) ExternalLinkOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ExternalLink {
-		return vs[0].([]ExternalLink)[vs[1].(int)]
-	}).(ExternalLinkOutput)
+func (o MetadataValueSuggestionArrayOutput) Index(i pulumi.IntInput) MetadataValueSuggestionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MetadataValueSuggestion {
+		return vs[0].([]MetadataValueSuggestion)[vs[1].(int)]
+	}).(MetadataValueSuggestionOutput)
+}
+
+type UpstreamEdge struct {
+	Created    *bool   `pulumi:"created"`
+	JobMrn     *string `pulumi:"jobMrn"`
+	ResourceId string  `pulumi:"resourceId"`
+	Source     string  `pulumi:"source"`
+}
+
+// UpstreamEdgeInput is an input type that accepts UpstreamEdgeArgs and UpstreamEdgeOutput values.
+// You can construct a concrete instance of `UpstreamEdgeInput` via:
+//
+//	UpstreamEdgeArgs{...}
+type UpstreamEdgeInput interface {
+	pulumi.Input
+
+	ToUpstreamEdgeOutput() UpstreamEdgeOutput
+	ToUpstreamEdgeOutputWithContext(context.Context) UpstreamEdgeOutput
+}
+
+type UpstreamEdgeArgs struct {
+	Created    pulumi.BoolPtrInput   `pulumi:"created"`
+	JobMrn     pulumi.StringPtrInput `pulumi:"jobMrn"`
+	ResourceId pulumi.StringInput    `pulumi:"resourceId"`
+	Source     pulumi.StringInput    `pulumi:"source"`
+}
+
+func (UpstreamEdgeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*UpstreamEdge)(nil)).Elem()
+}
+
+func (i UpstreamEdgeArgs) ToUpstreamEdgeOutput() UpstreamEdgeOutput {
+	return i.ToUpstreamEdgeOutputWithContext(context.Background())
+}
+
+func (i UpstreamEdgeArgs) ToUpstreamEdgeOutputWithContext(ctx context.Context) UpstreamEdgeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UpstreamEdgeOutput)
+}
+
+// UpstreamEdgeMapInput is an input type that accepts UpstreamEdgeMap and UpstreamEdgeMapOutput values.
+// You can construct a concrete instance of `UpstreamEdgeMapInput` via:
+//
+//	UpstreamEdgeMap{ "key": UpstreamEdgeArgs{...} }
+type UpstreamEdgeMapInput interface {
+	pulumi.Input
+
+	ToUpstreamEdgeMapOutput() UpstreamEdgeMapOutput
+	ToUpstreamEdgeMapOutputWithContext(context.Context) UpstreamEdgeMapOutput
+}
+
+type UpstreamEdgeMap map[string]UpstreamEdgeInput
+
+func (UpstreamEdgeMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]UpstreamEdge)(nil)).Elem()
+}
+
+func (i UpstreamEdgeMap) ToUpstreamEdgeMapOutput() UpstreamEdgeMapOutput {
+	return i.ToUpstreamEdgeMapOutputWithContext(context.Background())
+}
+
+func (i UpstreamEdgeMap) ToUpstreamEdgeMapOutputWithContext(ctx context.Context) UpstreamEdgeMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UpstreamEdgeMapOutput)
+}
+
+type UpstreamEdgeOutput struct{ *pulumi.OutputState }
+
+func (UpstreamEdgeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*UpstreamEdge)(nil)).Elem()
+}
+
+func (o UpstreamEdgeOutput) ToUpstreamEdgeOutput() UpstreamEdgeOutput {
+	return o
+}
+
+func (o UpstreamEdgeOutput) ToUpstreamEdgeOutputWithContext(ctx context.Context) UpstreamEdgeOutput {
+	return o
+}
+
+func (o UpstreamEdgeOutput) Created() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v UpstreamEdge) *bool { return v.Created }).(pulumi.BoolPtrOutput)
+}
+
+func (o UpstreamEdgeOutput) JobMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v UpstreamEdge) *string { return v.JobMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o UpstreamEdgeOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v UpstreamEdge) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o UpstreamEdgeOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v UpstreamEdge) string { return v.Source }).(pulumi.StringOutput)
+}
+
+type UpstreamEdgeMapOutput struct{ *pulumi.OutputState }
+
+func (UpstreamEdgeMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]UpstreamEdge)(nil)).Elem()
+}
+
+func (o UpstreamEdgeMapOutput) ToUpstreamEdgeMapOutput() UpstreamEdgeMapOutput {
+	return o
+}
+
+func (o UpstreamEdgeMapOutput) ToUpstreamEdgeMapOutputWithContext(ctx context.Context) UpstreamEdgeMapOutput {
+	return o
+}
+
+func (o UpstreamEdgeMapOutput) MapIndex(k pulumi.StringInput) UpstreamEdgeOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) UpstreamEdge {
+		return vs[0].(map[string]UpstreamEdge)[vs[1].(string)]
+	}).(UpstreamEdgeOutput)
+}
+
+type User struct {
+	Active     bool       `pulumi:"active"`
+	CreatedAt  *string    `pulumi:"createdAt"`
+	Name       string     `pulumi:"name"`
+	ResourceId string     `pulumi:"resourceId"`
+	Roles      []UserRole `pulumi:"roles"`
+	UpdatedAt  *string    `pulumi:"updatedAt"`
+	Username   string     `pulumi:"username"`
+}
+
+// UserInput is an input type that accepts UserArgs and UserOutput values.
+// You can construct a concrete instance of `UserInput` via:
+//
+//	UserArgs{...}
+type UserInput interface {
+	pulumi.Input
+
+	ToUserOutput() UserOutput
+	ToUserOutputWithContext(context.Context) UserOutput
+}
+
+type UserArgs struct {
+	Active     pulumi.BoolInput      `pulumi:"active"`
+	CreatedAt  pulumi.StringPtrInput `pulumi:"createdAt"`
+	Name       pulumi.StringInput    `pulumi:"name"`
+	ResourceId pulumi.StringInput    `pulumi:"resourceId"`
+	Roles      UserRoleArrayInput    `pulumi:"roles"`
+	UpdatedAt  pulumi.StringPtrInput `pulumi:"updatedAt"`
+	Username   pulumi.StringInput    `pulumi:"username"`
+}
+
+func (UserArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*User)(nil)).Elem()
+}
+
+func (i UserArgs) ToUserOutput() UserOutput {
+	return i.ToUserOutputWithContext(context.Background())
+}
+
+func (i UserArgs) ToUserOutputWithContext(ctx context.Context) UserOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserOutput)
+}
+
+// UserArrayInput is an input type that accepts UserArray and UserArrayOutput values.
+// You can construct a concrete instance of `UserArrayInput` via:
+//
+//	UserArray{ UserArgs{...} }
+type UserArrayInput interface {
+	pulumi.Input
+
+	ToUserArrayOutput() UserArrayOutput
+	ToUserArrayOutputWithContext(context.Context) UserArrayOutput
+}
+
+type UserArray []UserInput
+
+func (UserArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]User)(nil)).Elem()
+}
+
+func (i UserArray) ToUserArrayOutput() UserArrayOutput {
+	return i.ToUserArrayOutputWithContext(context.Background())
+}
+
+func (i UserArray) ToUserArrayOutputWithContext(ctx context.Context) UserArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserArrayOutput)
+}
+
+type UserOutput struct{ *pulumi.OutputState }
+
+func (UserOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*User)(nil)).Elem()
+}
+
+func (o UserOutput) ToUserOutput() UserOutput {
+	return o
+}
+
+func (o UserOutput) ToUserOutputWithContext(ctx context.Context) UserOutput {
+	return o
+}
+
+func (o UserOutput) Active() pulumi.BoolOutput {
+	return o.ApplyT(func(v User) bool { return v.Active }).(pulumi.BoolOutput)
+}
+
+func (o UserOutput) CreatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v User) *string { return v.CreatedAt }).(pulumi.StringPtrOutput)
+}
+
+func (o UserOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v User) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o UserOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v User) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+func (o UserOutput) Roles() UserRoleArrayOutput {
+	return o.ApplyT(func(v User) []UserRole { return v.Roles }).(UserRoleArrayOutput)
+}
+
+func (o UserOutput) UpdatedAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v User) *string { return v.UpdatedAt }).(pulumi.StringPtrOutput)
+}
+
+func (o UserOutput) Username() pulumi.StringOutput {
+	return o.ApplyT(func(v User) string { return v.Username }).(pulumi.StringOutput)
+}
+
+type UserArrayOutput struct{ *pulumi.OutputState }
+
+func (UserArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]User)(nil)).Elem()
+}
+
+func (o UserArrayOutput) ToUserArrayOutput() UserArrayOutput {
+	return o
+}
+
+func (o UserArrayOutput) ToUserArrayOutputWithContext(ctx context.Context) UserArrayOutput {
+	return o
+}
+
+func (o UserArrayOutput) Index(i pulumi.IntInput) UserOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) User {
+		return vs[0].([]User)[vs[1].(int)]
+	}).(UserOutput)
+}
+
+type UserRole struct {
+	Description *string  `pulumi:"description"`
+	Id          string   `pulumi:"id"`
+	Name        string   `pulumi:"name"`
+	Permissions []string `pulumi:"permissions"`
+}
+
+// UserRoleInput is an input type that accepts UserRoleArgs and UserRoleOutput values.
+// You can construct a concrete instance of `UserRoleInput` via:
+//
+//	UserRoleArgs{...}
+type UserRoleInput interface {
+	pulumi.Input
+
+	ToUserRoleOutput() UserRoleOutput
+	ToUserRoleOutputWithContext(context.Context) UserRoleOutput
+}
+
+type UserRoleArgs struct {
+	Description pulumi.StringPtrInput   `pulumi:"description"`
+	Id          pulumi.StringInput      `pulumi:"id"`
+	Name        pulumi.StringInput      `pulumi:"name"`
+	Permissions pulumi.StringArrayInput `pulumi:"permissions"`
+}
+
+func (UserRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*UserRole)(nil)).Elem()
+}
+
+func (i UserRoleArgs) ToUserRoleOutput() UserRoleOutput {
+	return i.ToUserRoleOutputWithContext(context.Background())
+}
+
+func (i UserRoleArgs) ToUserRoleOutputWithContext(ctx context.Context) UserRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserRoleOutput)
+}
+
+// UserRoleArrayInput is an input type that accepts UserRoleArray and UserRoleArrayOutput values.
+// You can construct a concrete instance of `UserRoleArrayInput` via:
+//
+//	UserRoleArray{ UserRoleArgs{...} }
+type UserRoleArrayInput interface {
+	pulumi.Input
+
+	ToUserRoleArrayOutput() UserRoleArrayOutput
+	ToUserRoleArrayOutputWithContext(context.Context) UserRoleArrayOutput
+}
+
+type UserRoleArray []UserRoleInput
+
+func (UserRoleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]UserRole)(nil)).Elem()
+}
+
+func (i UserRoleArray) ToUserRoleArrayOutput() UserRoleArrayOutput {
+	return i.ToUserRoleArrayOutputWithContext(context.Background())
+}
+
+func (i UserRoleArray) ToUserRoleArrayOutputWithContext(ctx context.Context) UserRoleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UserRoleArrayOutput)
+}
+
+type UserRoleOutput struct{ *pulumi.OutputState }
+
+func (UserRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*UserRole)(nil)).Elem()
+}
+
+func (o UserRoleOutput) ToUserRoleOutput() UserRoleOutput {
+	return o
+}
+
+func (o UserRoleOutput) ToUserRoleOutputWithContext(ctx context.Context) UserRoleOutput {
+	return o
+}
+
+func (o UserRoleOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v UserRole) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+func (o UserRoleOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v UserRole) string { return v.Id }).(pulumi.StringOutput)
+}
+
+func (o UserRoleOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v UserRole) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o UserRoleOutput) Permissions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v UserRole) []string { return v.Permissions }).(pulumi.StringArrayOutput)
+}
+
+type UserRoleArrayOutput struct{ *pulumi.OutputState }
+
+func (UserRoleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]UserRole)(nil)).Elem()
+}
+
+func (o UserRoleArrayOutput) ToUserRoleArrayOutput() UserRoleArrayOutput {
+	return o
+}
+
+func (o UserRoleArrayOutput) ToUserRoleArrayOutputWithContext(ctx context.Context) UserRoleArrayOutput {
+	return o
+}
+
+func (o UserRoleArrayOutput) Index(i pulumi.IntInput) UserRoleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) UserRole {
+		return vs[0].([]UserRole)[vs[1].(int)]
+	}).(UserRoleOutput)
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionItemInput)(nil)).Elem(), AssetCollectionItemArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionItemPtrInput)(nil)).Elem(), AssetCollectionItemArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionItemArrayInput)(nil)).Elem(), AssetCollectionItemArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetCollectionItemMapInput)(nil)).Elem(), AssetCollectionItemMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AssetEnvironmentInput)(nil)).Elem(), AssetEnvironmentArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AssetEnvironmentMapInput)(nil)).Elem(), AssetEnvironmentMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetFiltersInput)(nil)).Elem(), AssetFiltersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetMatchInput)(nil)).Elem(), AssetMatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetMatchArrayInput)(nil)).Elem(), AssetMatchArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AssetSourceInput)(nil)).Elem(), AssetSourceArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*AssetSourceArrayInput)(nil)).Elem(), AssetSourceArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetSummaryInput)(nil)).Elem(), AssetSummaryArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AssetSummaryArrayInput)(nil)).Elem(), AssetSummaryArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CatalogAssetInput)(nil)).Elem(), CatalogAssetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CatalogAssetPtrInput)(nil)).Elem(), CatalogAssetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CatalogAssetArrayInput)(nil)).Elem(), CatalogAssetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DocumentResultInput)(nil)).Elem(), DocumentResultArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DocumentResultMapInput)(nil)).Elem(), DocumentResultMap{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ExternalLinkInput)(nil)).Elem(), ExternalLinkArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*ExternalLinkArrayInput)(nil)).Elem(), ExternalLinkArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageEdgeInput)(nil)).Elem(), LineageEdgeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageEdgeArrayInput)(nil)).Elem(), LineageEdgeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageNodeInput)(nil)).Elem(), LineageNodeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageNodeArrayInput)(nil)).Elem(), LineageNodeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetEdgeInput)(nil)).Elem(), LineageSetEdgeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetEdgeArrayInput)(nil)).Elem(), LineageSetEdgeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetEdgeResultInput)(nil)).Elem(), LineageSetEdgeResultArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LineageSetEdgeResultArrayInput)(nil)).Elem(), LineageSetEdgeResultArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ManagedAssetInput)(nil)).Elem(), ManagedAssetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ManagedAssetArrayInput)(nil)).Elem(), ManagedAssetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MetadataFieldSuggestionInput)(nil)).Elem(), MetadataFieldSuggestionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MetadataFieldSuggestionArrayInput)(nil)).Elem(), MetadataFieldSuggestionArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MetadataValueSuggestionInput)(nil)).Elem(), MetadataValueSuggestionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MetadataValueSuggestionArrayInput)(nil)).Elem(), MetadataValueSuggestionArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UpstreamEdgeInput)(nil)).Elem(), UpstreamEdgeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UpstreamEdgeMapInput)(nil)).Elem(), UpstreamEdgeMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UserInput)(nil)).Elem(), UserArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UserArrayInput)(nil)).Elem(), UserArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UserRoleInput)(nil)).Elem(), UserRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UserRoleArrayInput)(nil)).Elem(), UserRoleArray{})
+	pulumi.RegisterOutputType(AssetCollectionItemOutput{})
+	pulumi.RegisterOutputType(AssetCollectionItemPtrOutput{})
+	pulumi.RegisterOutputType(AssetCollectionItemArrayOutput{})
+	pulumi.RegisterOutputType(AssetCollectionItemMapOutput{})
 	pulumi.RegisterOutputType(AssetEnvironmentOutput{})
 	pulumi.RegisterOutputType(AssetEnvironmentMapOutput{})
+	pulumi.RegisterOutputType(AssetFiltersOutput{})
+	pulumi.RegisterOutputType(AssetMatchOutput{})
+	pulumi.RegisterOutputType(AssetMatchArrayOutput{})
 	pulumi.RegisterOutputType(AssetSourceOutput{})
 	pulumi.RegisterOutputType(AssetSourceArrayOutput{})
+	pulumi.RegisterOutputType(AssetSummaryOutput{})
+	pulumi.RegisterOutputType(AssetSummaryArrayOutput{})
+	pulumi.RegisterOutputType(CatalogAssetOutput{})
+	pulumi.RegisterOutputType(CatalogAssetPtrOutput{})
+	pulumi.RegisterOutputType(CatalogAssetArrayOutput{})
+	pulumi.RegisterOutputType(DocumentResultOutput{})
+	pulumi.RegisterOutputType(DocumentResultMapOutput{})
 	pulumi.RegisterOutputType(ExternalLinkOutput{})
 	pulumi.RegisterOutputType(ExternalLinkArrayOutput{})
+	pulumi.RegisterOutputType(LineageEdgeOutput{})
+	pulumi.RegisterOutputType(LineageEdgeArrayOutput{})
+	pulumi.RegisterOutputType(LineageNodeOutput{})
+	pulumi.RegisterOutputType(LineageNodeArrayOutput{})
+	pulumi.RegisterOutputType(LineageSetEdgeOutput{})
+	pulumi.RegisterOutputType(LineageSetEdgeArrayOutput{})
+	pulumi.RegisterOutputType(LineageSetEdgeResultOutput{})
+	pulumi.RegisterOutputType(LineageSetEdgeResultArrayOutput{})
+	pulumi.RegisterOutputType(ManagedAssetOutput{})
+	pulumi.RegisterOutputType(ManagedAssetArrayOutput{})
+	pulumi.RegisterOutputType(MetadataFieldSuggestionOutput{})
+	pulumi.RegisterOutputType(MetadataFieldSuggestionArrayOutput{})
+	pulumi.RegisterOutputType(MetadataValueSuggestionOutput{})
+	pulumi.RegisterOutputType(MetadataValueSuggestionArrayOutput{})
+	pulumi.RegisterOutputType(UpstreamEdgeOutput{})
+	pulumi.RegisterOutputType(UpstreamEdgeMapOutput{})
+	pulumi.RegisterOutputType(UserOutput{})
+	pulumi.RegisterOutputType(UserArrayOutput{})
+	pulumi.RegisterOutputType(UserRoleOutput{})
+	pulumi.RegisterOutputType(UserRoleArrayOutput{})
 }
@@ -0,0 +1,237 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package marmot
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/marmotdata/pulumi-marmot/sdk/go/marmot/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type UpstreamLineage struct {
+	pulumi.CustomResourceState
+
+	Edges    UpstreamEdgeMapOutput    `pulumi:"edges"`
+	JobMrn   pulumi.StringPtrOutput   `pulumi:"jobMrn"`
+	Sources  pulumi.StringArrayOutput `pulumi:"sources"`
+	Target   pulumi.StringOutput      `pulumi:"target"`
+	TargetId pulumi.StringOutput      `pulumi:"targetId"`
+}
+
+// NewUpstreamLineage registers a new resource with the given unique name, arguments, and options.
+func NewUpstreamLineage(ctx *pulumi.Context,
+	name string, args *UpstreamLineageArgs, opts ...pulumi.ResourceOption) (*UpstreamLineage, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Sources == nil {
+		return nil, errors.New("invalid value for required argument 'Sources'")
+	}
+	if args.Target == nil {
+		return nil, errors.New("invalid value for required argument 'Target'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource UpstreamLineage
+	err := ctx.RegisterResource("marmot:index:UpstreamLineage", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetUpstreamLineage gets an existing UpstreamLineage resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetUpstreamLineage(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *UpstreamLineageState, opts ...pulumi.ResourceOption) (*UpstreamLineage, error) {
+	var resource UpstreamLineage
+	err := ctx.ReadResource("marmot:index:UpstreamLineage", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering UpstreamLineage resources.
+type upstreamLineageState struct {
+}
+
+type UpstreamLineageState struct {
+}
+
+func (UpstreamLineageState) ElementType() reflect.Type {
+	return reflect.TypeOf((*upstreamLineageState)(nil)).Elem()
+}
+
+type upstreamLineageArgs struct {
+	JobMrn  *string  `pulumi:"jobMrn"`
+	Sources []string `pulumi:"sources"`
+	Target  string   `pulumi:"target"`
+}
+
+// The set of arguments for constructing a UpstreamLineage resource.
+type UpstreamLineageArgs struct {
+	JobMrn  pulumi.StringPtrInput
+	Sources pulumi.StringArrayInput
+	Target  pulumi.StringInput
+}
+
+func (UpstreamLineageArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*upstreamLineageArgs)(nil)).Elem()
+}
+
+type UpstreamLineageInput interface {
+	pulumi.Input
+
+	ToUpstreamLineageOutput() UpstreamLineageOutput
+	ToUpstreamLineageOutputWithContext(ctx context.Context) UpstreamLineageOutput
+}
+
+func (*UpstreamLineage) ElementType() reflect.Type {
+	return reflect.TypeOf((**UpstreamLineage)(nil)).Elem()
+}
+
+func (i *UpstreamLineage) ToUpstreamLineageOutput() UpstreamLineageOutput {
+	return i.ToUpstreamLineageOutputWithContext(context.Background())
+}
+
+func (i *UpstreamLineage) ToUpstreamLineageOutputWithContext(ctx context.Context) UpstreamLineageOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UpstreamLineageOutput)
+}
+
+// UpstreamLineageArrayInput is an input type that accepts UpstreamLineageArray and UpstreamLineageArrayOutput values.
+// You can construct a concrete instance of `UpstreamLineageArrayInput` via:
+//
+//	UpstreamLineageArray{ UpstreamLineageArgs{...} }
+type UpstreamLineageArrayInput interface {
+	pulumi.Input
+
+	ToUpstreamLineageArrayOutput() UpstreamLineageArrayOutput
+	ToUpstreamLineageArrayOutputWithContext(context.Context) UpstreamLineageArrayOutput
+}
+
+type UpstreamLineageArray []UpstreamLineageInput
+
+func (UpstreamLineageArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*UpstreamLineage)(nil)).Elem()
+}
+
+func (i UpstreamLineageArray) ToUpstreamLineageArrayOutput() UpstreamLineageArrayOutput {
+	return i.ToUpstreamLineageArrayOutputWithContext(context.Background())
+}
+
+func (i UpstreamLineageArray) ToUpstreamLineageArrayOutputWithContext(ctx context.Context) UpstreamLineageArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UpstreamLineageArrayOutput)
+}
+
+// UpstreamLineageMapInput is an input type that accepts UpstreamLineageMap and UpstreamLineageMapOutput values.
+// You can construct a concrete instance of `UpstreamLineageMapInput` via:
+//
+//	UpstreamLineageMap{ "key": UpstreamLineageArgs{...} }
+type UpstreamLineageMapInput interface {
+	pulumi.Input
+
+	ToUpstreamLineageMapOutput() UpstreamLineageMapOutput
+	ToUpstreamLineageMapOutputWithContext(context.Context) UpstreamLineageMapOutput
+}
+
+type UpstreamLineageMap map[string]UpstreamLineageInput
+
+func (UpstreamLineageMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*UpstreamLineage)(nil)).Elem()
+}
+
+func (i UpstreamLineageMap) ToUpstreamLineageMapOutput() UpstreamLineageMapOutput {
+	return i.ToUpstreamLineageMapOutputWithContext(context.Background())
+}
+
+func (i UpstreamLineageMap) ToUpstreamLineageMapOutputWithContext(ctx context.Context) UpstreamLineageMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(UpstreamLineageMapOutput)
+}
+
+type UpstreamLineageOutput struct{ *pulumi.OutputState }
+
+func (UpstreamLineageOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**UpstreamLineage)(nil)).Elem()
+}
+
+func (o UpstreamLineageOutput) ToUpstreamLineageOutput() UpstreamLineageOutput {
+	return o
+}
+
+func (o UpstreamLineageOutput) ToUpstreamLineageOutputWithContext(ctx context.Context) UpstreamLineageOutput {
+	return o
+}
+
+func (o UpstreamLineageOutput) Edges() UpstreamEdgeMapOutput {
+	return o.ApplyT(func(v *UpstreamLineage) UpstreamEdgeMapOutput { return v.Edges }).(UpstreamEdgeMapOutput)
+}
+
+func (o UpstreamLineageOutput) JobMrn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *UpstreamLineage) pulumi.StringPtrOutput { return v.JobMrn }).(pulumi.StringPtrOutput)
+}
+
+func (o UpstreamLineageOutput) Sources() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *UpstreamLineage) pulumi.StringArrayOutput { return v.Sources }).(pulumi.StringArrayOutput)
+}
+
+func (o UpstreamLineageOutput) Target() pulumi.StringOutput {
+	return o.ApplyT(func(v *UpstreamLineage) pulumi.StringOutput { return v.Target }).(pulumi.StringOutput)
+}
+
+func (o UpstreamLineageOutput) TargetId() pulumi.StringOutput {
+	return o.ApplyT(func(v *UpstreamLineage) pulumi.StringOutput { return v.TargetId }).(pulumi.StringOutput)
+}
+
+type UpstreamLineageArrayOutput struct{ *pulumi.OutputState }
+
+func (UpstreamLineageArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*UpstreamLineage)(nil)).Elem()
+}
+
+func (o UpstreamLineageArrayOutput) ToUpstreamLineageArrayOutput() UpstreamLineageArrayOutput {
+	return o
+}
+
+func (o UpstreamLineageArrayOutput) ToUpstreamLineageArrayOutputWithContext(ctx context.Context) UpstreamLineageArrayOutput {
+	return o
+}
+
+func (o UpstreamLineageArrayOutput) Index(i pulumi.IntInput) UpstreamLineageOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *UpstreamLineage {
+		return vs[0].([]*UpstreamLineage)[vs[1].(int)]
+	}).(UpstreamLineageOutput)
+}
+
+type UpstreamLineageMapOutput struct{ *pulumi.OutputState }
+
+func (UpstreamLineageMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*UpstreamLineage)(nil)).Elem()
+}
+
+func (o UpstreamLineageMapOutput) ToUpstreamLineageMapOutput() UpstreamLineageMapOutput {
+	return o
+}
+
+func (o UpstreamLineageMapOutput) ToUpstreamLineageMapOutputWithContext(ctx context.Context) UpstreamLineageMapOutput {
+	return o
+}
+
+func (o UpstreamLineageMapOutput) MapIndex(k pulumi.StringInput) UpstreamLineageOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *UpstreamLineage {
+		return vs[0].(map[string]*UpstreamLineage)[vs[1].(string)]
+	}).(UpstreamLineageOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*UpstreamLineageInput)(nil)).Elem(), &UpstreamLineage{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UpstreamLineageArrayInput)(nil)).Elem(), UpstreamLineageArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*UpstreamLineageMapInput)(nil)).Elem(), UpstreamLineageMap{})
+	pulumi.RegisterOutputType(UpstreamLineageOutput{})
+	pulumi.RegisterOutputType(UpstreamLineageArrayOutput{})
+	pulumi.RegisterOutputType(UpstreamLineageMapOutput{})
+}
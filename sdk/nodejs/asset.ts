@@ -38,12 +38,16 @@ export class Asset extends pulumi.CustomResource {
     public readonly description!: pulumi.Output<string | undefined>;
     public readonly environments!: pulumi.Output<{[key: string]: outputs.AssetEnvironment} | undefined>;
     public readonly externalLinks!: pulumi.Output<outputs.ExternalLink[] | undefined>;
+    public readonly ignoreMetadataKeys!: pulumi.Output<string[] | undefined>;
     public /*out*/ readonly lastSyncAt!: pulumi.Output<string | undefined>;
     public readonly metadata!: pulumi.Output<{[key: string]: any} | undefined>;
     public /*out*/ readonly mrn!: pulumi.Output<string>;
     public readonly name!: pulumi.Output<string>;
     public /*out*/ readonly resourceId!: pulumi.Output<string>;
     public readonly schema!: pulumi.Output<{[key: string]: any} | undefined>;
+    public readonly schemaFile!: pulumi.Output<string | undefined>;
+    public readonly schemaFormat!: pulumi.Output<string | undefined>;
+    public readonly schemaMessage!: pulumi.Output<string | undefined>;
     public readonly services!: pulumi.Output<string[]>;
     public readonly sources!: pulumi.Output<outputs.AssetSource[] | undefined>;
     public readonly tags!: pulumi.Output<string[] | undefined>;
@@ -73,9 +77,13 @@ export class Asset extends pulumi.CustomResource {
             resourceInputs["description"] = args ? args.description : undefined;
             resourceInputs["environments"] = args ? args.environments : undefined;
             resourceInputs["externalLinks"] = args ? args.externalLinks : undefined;
+            resourceInputs["ignoreMetadataKeys"] = args ? args.ignoreMetadataKeys : undefined;
             resourceInputs["metadata"] = args ? args.metadata : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
             resourceInputs["schema"] = args ? args.schema : undefined;
+            resourceInputs["schemaFile"] = args ? args.schemaFile : undefined;
+            resourceInputs["schemaFormat"] = args ? args.schemaFormat : undefined;
+            resourceInputs["schemaMessage"] = args ? args.schemaMessage : undefined;
             resourceInputs["services"] = args ? args.services : undefined;
             resourceInputs["sources"] = args ? args.sources : undefined;
             resourceInputs["tags"] = args ? args.tags : undefined;
@@ -92,12 +100,16 @@ export class Asset extends pulumi.CustomResource {
             resourceInputs["description"] = undefined /*out*/;
             resourceInputs["environments"] = undefined /*out*/;
             resourceInputs["externalLinks"] = undefined /*out*/;
+            resourceInputs["ignoreMetadataKeys"] = undefined /*out*/;
             resourceInputs["lastSyncAt"] = undefined /*out*/;
             resourceInputs["metadata"] = undefined /*out*/;
             resourceInputs["mrn"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["resourceId"] = undefined /*out*/;
             resourceInputs["schema"] = undefined /*out*/;
+            resourceInputs["schemaFile"] = undefined /*out*/;
+            resourceInputs["schemaFormat"] = undefined /*out*/;
+            resourceInputs["schemaMessage"] = undefined /*out*/;
             resourceInputs["services"] = undefined /*out*/;
             resourceInputs["sources"] = undefined /*out*/;
             resourceInputs["tags"] = undefined /*out*/;
@@ -116,9 +128,13 @@ export interface AssetArgs {
     description?: pulumi.Input<string>;
     environments?: pulumi.Input<{[key: string]: pulumi.Input<inputs.AssetEnvironmentArgs>}>;
     externalLinks?: pulumi.Input<pulumi.Input<inputs.ExternalLinkArgs>[]>;
+    ignoreMetadataKeys?: pulumi.Input<pulumi.Input<string>[]>;
     metadata?: pulumi.Input<{[key: string]: any}>;
     name: pulumi.Input<string>;
     schema?: pulumi.Input<{[key: string]: any}>;
+    schemaFile?: pulumi.Input<string>;
+    schemaFormat?: pulumi.Input<string>;
+    schemaMessage?: pulumi.Input<string>;
     services: pulumi.Input<pulumi.Input<string>[]>;
     sources?: pulumi.Input<pulumi.Input<inputs.AssetSourceArgs>[]>;
     tags?: pulumi.Input<pulumi.Input<string>[]>;
@@ -0,0 +1,76 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export class AssetCollection extends pulumi.CustomResource {
+    /**
+     * Get an existing AssetCollection resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): AssetCollection {
+        return new AssetCollection(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'marmot:index:AssetCollection';
+
+    /**
+     * Returns true if the given object is an instance of AssetCollection.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AssetCollection {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AssetCollection.__pulumiType;
+    }
+
+    public readonly assets!: pulumi.Output<{[key: string]: outputs.AssetCollectionItem}>;
+    public readonly config!: pulumi.Output<{[key: string]: any} | undefined>;
+    public /*out*/ readonly mrns!: pulumi.Output<{[key: string]: string}>;
+    public /*out*/ readonly resourceIds!: pulumi.Output<{[key: string]: string}>;
+
+    /**
+     * Create a AssetCollection resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AssetCollectionArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.assets === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'assets'");
+            }
+            resourceInputs["assets"] = args ? args.assets : undefined;
+            resourceInputs["config"] = args ? args.config : undefined;
+            resourceInputs["mrns"] = undefined /*out*/;
+            resourceInputs["resourceIds"] = undefined /*out*/;
+        } else {
+            resourceInputs["assets"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["mrns"] = undefined /*out*/;
+            resourceInputs["resourceIds"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(AssetCollection.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AssetCollection resource.
+ */
+export interface AssetCollectionArgs {
+    assets: pulumi.Input<{[key: string]: pulumi.Input<inputs.AssetCollectionItemArgs>}>;
+    config?: pulumi.Input<{[key: string]: any}>;
+}
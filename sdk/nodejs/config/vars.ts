@@ -7,6 +7,14 @@ import * as utilities from "../utilities";
 declare var exports: any;
 const __config = new pulumi.Config("marmot");
 
+export declare const allowedTags: string[] | undefined;
+Object.defineProperty(exports, "allowedTags", {
+    get() {
+        return __config.getObject<string[]>("allowedTags");
+    },
+    enumerable: true,
+});
+
 export declare const apiKey: string | undefined;
 Object.defineProperty(exports, "apiKey", {
     get() {
@@ -15,6 +23,54 @@ Object.defineProperty(exports, "apiKey", {
     enumerable: true,
 });
 
+export declare const batchCreates: boolean | undefined;
+Object.defineProperty(exports, "batchCreates", {
+    get() {
+        return __config.getObject<boolean>("batchCreates");
+    },
+    enumerable: true,
+});
+
+export declare const batchSize: number | undefined;
+Object.defineProperty(exports, "batchSize", {
+    get() {
+        return __config.getObject<number>("batchSize");
+    },
+    enumerable: true,
+});
+
+export declare const batchWindowMs: number | undefined;
+Object.defineProperty(exports, "batchWindowMs", {
+    get() {
+        return __config.getObject<number>("batchWindowMs");
+    },
+    enumerable: true,
+});
+
+export declare const defaultMetadata: {[key: string]: any} | undefined;
+Object.defineProperty(exports, "defaultMetadata", {
+    get() {
+        return __config.getObject<{[key: string]: any}>("defaultMetadata");
+    },
+    enumerable: true,
+});
+
+export declare const defaultTags: string[] | undefined;
+Object.defineProperty(exports, "defaultTags", {
+    get() {
+        return __config.getObject<string[]>("defaultTags");
+    },
+    enumerable: true,
+});
+
+export declare const failFast: boolean | undefined;
+Object.defineProperty(exports, "failFast", {
+    get() {
+        return __config.getObject<boolean>("failFast");
+    },
+    enumerable: true,
+});
+
 export declare const host: string | undefined;
 Object.defineProperty(exports, "host", {
     get() {
@@ -23,3 +79,27 @@ Object.defineProperty(exports, "host", {
     enumerable: true,
 });
 
+export declare const ignoreMetadataKeys: string[] | undefined;
+Object.defineProperty(exports, "ignoreMetadataKeys", {
+    get() {
+        return __config.getObject<string[]>("ignoreMetadataKeys");
+    },
+    enumerable: true,
+});
+
+export declare const provenance: boolean | undefined;
+Object.defineProperty(exports, "provenance", {
+    get() {
+        return __config.getObject<boolean>("provenance");
+    },
+    enumerable: true,
+});
+
+export declare const strictTags: string | undefined;
+Object.defineProperty(exports, "strictTags", {
+    get() {
+        return __config.get("strictTags");
+    },
+    enumerable: true,
+});
+
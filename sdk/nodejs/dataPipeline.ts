@@ -0,0 +1,68 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export class DataPipeline extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'marmot:index:DataPipeline';
+
+    /**
+     * Returns true if the given object is an instance of DataPipeline.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is DataPipeline {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === DataPipeline.__pulumiType;
+    }
+
+    public /*out*/ readonly edges!: pulumi.Output<{[key: string]: string}[]>;
+    public readonly inputMrns!: pulumi.Output<string[]>;
+    public readonly jobMrn!: pulumi.Output<string>;
+    public readonly outputMrns!: pulumi.Output<string[]>;
+
+    /**
+     * Create a DataPipeline resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: DataPipelineArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["inputMrns"] = args ? args.inputMrns : undefined;
+            resourceInputs["inputs"] = args ? args.inputs : undefined;
+            resourceInputs["job"] = args ? args.job : undefined;
+            resourceInputs["jobMrn"] = args ? args.jobMrn : undefined;
+            resourceInputs["outputMrns"] = args ? args.outputMrns : undefined;
+            resourceInputs["outputs"] = args ? args.outputs : undefined;
+            resourceInputs["edges"] = undefined /*out*/;
+        } else {
+            resourceInputs["edges"] = undefined /*out*/;
+            resourceInputs["inputMrns"] = undefined /*out*/;
+            resourceInputs["jobMrn"] = undefined /*out*/;
+            resourceInputs["outputMrns"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(DataPipeline.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a DataPipeline resource.
+ */
+export interface DataPipelineArgs {
+    inputMrns?: pulumi.Input<string[]>;
+    inputs?: pulumi.Input<pulumi.Input<inputs.AssetCollectionItemArgs>[]>;
+    job?: pulumi.Input<inputs.AssetCollectionItemArgs>;
+    jobMrn?: pulumi.Input<string>;
+    outputMrns?: pulumi.Input<string[]>;
+    outputs?: pulumi.Input<pulumi.Input<inputs.AssetCollectionItemArgs>[]>;
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export class DocumentationSet extends pulumi.CustomResource {
+    /**
+     * Get an existing DocumentationSet resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): DocumentationSet {
+        return new DocumentationSet(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'marmot:index:DocumentationSet';
+
+    /**
+     * Returns true if the given object is an instance of DocumentationSet.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is DocumentationSet {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === DocumentationSet.__pulumiType;
+    }
+
+    public readonly directory!: pulumi.Output<string>;
+    public /*out*/ readonly documents!: pulumi.Output<{[key: string]: outputs.DocumentResult}>;
+    public readonly globalDocs!: pulumi.Output<{[key: string]: string[]} | undefined>;
+    public readonly mrnTemplate!: pulumi.Output<string | undefined>;
+    public readonly source!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a DocumentationSet resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DocumentationSetArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.directory === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'directory'");
+            }
+            resourceInputs["directory"] = args ? args.directory : undefined;
+            resourceInputs["globalDocs"] = args ? args.globalDocs : undefined;
+            resourceInputs["mrnTemplate"] = args ? args.mrnTemplate : undefined;
+            resourceInputs["source"] = args ? args.source : undefined;
+            resourceInputs["documents"] = undefined /*out*/;
+        } else {
+            resourceInputs["directory"] = undefined /*out*/;
+            resourceInputs["documents"] = undefined /*out*/;
+            resourceInputs["globalDocs"] = undefined /*out*/;
+            resourceInputs["mrnTemplate"] = undefined /*out*/;
+            resourceInputs["source"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(DocumentationSet.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a DocumentationSet resource.
+ */
+export interface DocumentationSetArgs {
+    directory: pulumi.Input<string>;
+    globalDocs?: pulumi.Input<{[key: string]: pulumi.Input<pulumi.Input<string>[]>}>;
+    mrnTemplate?: pulumi.Input<string>;
+    source?: pulumi.Input<string>;
+}
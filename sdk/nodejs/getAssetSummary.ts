@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export function getAssetSummary(args?: GetAssetSummaryArgs, opts?: pulumi.InvokeOptions): Promise<GetAssetSummaryResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getAssetSummary", {
+        "minServiceCounts": args.minServiceCounts,
+        "minTagCounts": args.minTagCounts,
+        "minTypeCounts": args.minTypeCounts,
+    }, opts);
+}
+
+export interface GetAssetSummaryArgs {
+    minServiceCounts?: {[key: string]: number};
+    minTagCounts?: {[key: string]: number};
+    minTypeCounts?: {[key: string]: number};
+}
+
+export interface GetAssetSummaryResult {
+    readonly services: {[key: string]: number};
+    readonly tags: {[key: string]: number};
+    readonly total: number;
+    readonly types: {[key: string]: number};
+}
+export function getAssetSummaryOutput(args?: GetAssetSummaryOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetAssetSummaryResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getAssetSummary", {
+        "minServiceCounts": args.minServiceCounts,
+        "minTagCounts": args.minTagCounts,
+        "minTypeCounts": args.minTypeCounts,
+    }, opts);
+}
+
+export interface GetAssetSummaryOutputArgs {
+    minServiceCounts?: pulumi.Input<{[key: string]: pulumi.Input<number>}>;
+    minTagCounts?: pulumi.Input<{[key: string]: pulumi.Input<number>}>;
+    minTypeCounts?: pulumi.Input<{[key: string]: pulumi.Input<number>}>;
+}
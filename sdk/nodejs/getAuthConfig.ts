@@ -0,0 +1,25 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export function getAuthConfig(args?: GetAuthConfigArgs, opts?: pulumi.InvokeOptions): Promise<GetAuthConfigResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getAuthConfig", {
+    }, opts);
+}
+
+export interface GetAuthConfigArgs {
+}
+
+export interface GetAuthConfigResult {
+    readonly enabledProviders: string[];
+}
+export function getAuthConfigOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetAuthConfigResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getAuthConfig", {
+    }, opts);
+}
+
@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function getCatalogAsset(args?: GetCatalogAssetArgs, opts?: pulumi.InvokeOptions): Promise<GetCatalogAssetResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getCatalogAsset", {
+        "mrn": args.mrn,
+        "resourceId": args.resourceId,
+    }, opts);
+}
+
+export interface GetCatalogAssetArgs {
+    mrn?: string;
+    resourceId?: string;
+}
+
+export interface GetCatalogAssetResult {
+    readonly createdAt: string;
+    readonly createdBy: string;
+    readonly description?: string;
+    readonly environments?: {[key: string]: outputs.AssetEnvironment};
+    readonly externalLinks?: outputs.ExternalLink[];
+    readonly lastSyncAt?: string;
+    readonly metadata?: {[key: string]: any};
+    readonly mrn: string;
+    readonly name: string;
+    readonly parentMrn?: string;
+    readonly resourceId: string;
+    readonly schema?: {[key: string]: any};
+    readonly services: string[];
+    readonly sources?: outputs.AssetSource[];
+    readonly tags?: string[];
+    readonly type: string;
+    readonly updatedAt: string;
+}
+export function getCatalogAssetOutput(args?: GetCatalogAssetOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCatalogAssetResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getCatalogAsset", {
+        "mrn": args.mrn,
+        "resourceId": args.resourceId,
+    }, opts);
+}
+
+export interface GetCatalogAssetOutputArgs {
+    mrn?: pulumi.Input<string>;
+    resourceId?: pulumi.Input<string>;
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function getCurrentUser(args?: GetCurrentUserArgs, opts?: pulumi.InvokeOptions): Promise<GetCurrentUserResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getCurrentUser", {
+    }, opts);
+}
+
+export interface GetCurrentUserArgs {
+}
+
+export interface GetCurrentUserResult {
+    readonly active: boolean;
+    readonly createdAt?: string;
+    readonly name: string;
+    readonly resourceId: string;
+    readonly roles: outputs.UserRole[];
+    readonly updatedAt?: string;
+    readonly username: string;
+}
+export function getCurrentUserOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCurrentUserResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getCurrentUser", {
+    }, opts);
+}
+
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function getLineage(args: GetLineageArgs, opts?: pulumi.InvokeOptions): Promise<GetLineageResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getLineage", {
+        "depth": args.depth,
+        "direction": args.direction,
+        "resourceId": args.resourceId,
+    }, opts);
+}
+
+export interface GetLineageArgs {
+    depth?: number;
+    direction?: string;
+    resourceId: string;
+}
+
+export interface GetLineageResult {
+    readonly edges: outputs.LineageEdge[];
+    readonly nodes: outputs.LineageNode[];
+}
+export function getLineageOutput(args: GetLineageOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetLineageResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getLineage", {
+        "depth": args.depth,
+        "direction": args.direction,
+        "resourceId": args.resourceId,
+    }, opts);
+}
+
+export interface GetLineageOutputArgs {
+    depth?: pulumi.Input<number>;
+    direction?: pulumi.Input<string>;
+    resourceId: pulumi.Input<string>;
+}
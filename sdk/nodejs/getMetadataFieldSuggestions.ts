@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function getMetadataFieldSuggestions(args?: GetMetadataFieldSuggestionsArgs, opts?: pulumi.InvokeOptions): Promise<GetMetadataFieldSuggestionsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getMetadataFieldSuggestions", {
+        "limit": args.limit,
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetMetadataFieldSuggestionsArgs {
+    limit?: number;
+    prefix?: string;
+}
+
+export interface GetMetadataFieldSuggestionsResult {
+    readonly fields: outputs.MetadataFieldSuggestion[];
+}
+export function getMetadataFieldSuggestionsOutput(args?: GetMetadataFieldSuggestionsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetMetadataFieldSuggestionsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getMetadataFieldSuggestions", {
+        "limit": args.limit,
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetMetadataFieldSuggestionsOutputArgs {
+    limit?: pulumi.Input<number>;
+    prefix?: pulumi.Input<string>;
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function getMetadataValueSuggestions(args: GetMetadataValueSuggestionsArgs, opts?: pulumi.InvokeOptions): Promise<GetMetadataValueSuggestionsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getMetadataValueSuggestions", {
+        "field": args.field,
+        "limit": args.limit,
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetMetadataValueSuggestionsArgs {
+    field: string;
+    limit?: number;
+    prefix?: string;
+}
+
+export interface GetMetadataValueSuggestionsResult {
+    readonly values: outputs.MetadataValueSuggestion[];
+}
+export function getMetadataValueSuggestionsOutput(args: GetMetadataValueSuggestionsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetMetadataValueSuggestionsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getMetadataValueSuggestions", {
+        "field": args.field,
+        "limit": args.limit,
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetMetadataValueSuggestionsOutputArgs {
+    field: pulumi.Input<string>;
+    limit?: pulumi.Input<number>;
+    prefix?: pulumi.Input<string>;
+}
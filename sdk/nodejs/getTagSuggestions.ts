@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export function getTagSuggestions(args?: GetTagSuggestionsArgs, opts?: pulumi.InvokeOptions): Promise<GetTagSuggestionsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:getTagSuggestions", {
+        "limit": args.limit,
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetTagSuggestionsArgs {
+    limit?: number;
+    prefix?: string;
+}
+
+export interface GetTagSuggestionsResult {
+    readonly tags: string[];
+}
+export function getTagSuggestionsOutput(args?: GetTagSuggestionsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetTagSuggestionsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:getTagSuggestions", {
+        "limit": args.limit,
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetTagSuggestionsOutputArgs {
+    limit?: pulumi.Input<number>;
+    prefix?: pulumi.Input<string>;
+}
@@ -10,16 +10,111 @@ export type Asset = import("./asset").Asset;
 export const Asset: typeof import("./asset").Asset = null as any;
 utilities.lazyLoad(exports, ["Asset"], () => require("./asset"));
 
+export { AssetCollectionArgs } from "./assetCollection";
+export type AssetCollection = import("./assetCollection").AssetCollection;
+export const AssetCollection: typeof import("./assetCollection").AssetCollection = null as any;
+utilities.lazyLoad(exports, ["AssetCollection"], () => require("./assetCollection"));
+
+export { DataPipelineArgs } from "./dataPipeline";
+export type DataPipeline = import("./dataPipeline").DataPipeline;
+export const DataPipeline: typeof import("./dataPipeline").DataPipeline = null as any;
+utilities.lazyLoad(exports, ["DataPipeline"], () => require("./dataPipeline"));
+
+export { DocumentationSetArgs } from "./documentationSet";
+export type DocumentationSet = import("./documentationSet").DocumentationSet;
+export const DocumentationSet: typeof import("./documentationSet").DocumentationSet = null as any;
+utilities.lazyLoad(exports, ["DocumentationSet"], () => require("./documentationSet"));
+
+export { GetAssetSummaryArgs, GetAssetSummaryResult, GetAssetSummaryOutputArgs } from "./getAssetSummary";
+export const getAssetSummary: typeof import("./getAssetSummary").getAssetSummary = null as any;
+export const getAssetSummaryOutput: typeof import("./getAssetSummary").getAssetSummaryOutput = null as any;
+utilities.lazyLoad(exports, ["getAssetSummary","getAssetSummaryOutput"], () => require("./getAssetSummary"));
+
+export { GetAuthConfigArgs, GetAuthConfigResult } from "./getAuthConfig";
+export const getAuthConfig: typeof import("./getAuthConfig").getAuthConfig = null as any;
+export const getAuthConfigOutput: typeof import("./getAuthConfig").getAuthConfigOutput = null as any;
+utilities.lazyLoad(exports, ["getAuthConfig","getAuthConfigOutput"], () => require("./getAuthConfig"));
+
+export { GetCatalogAssetArgs, GetCatalogAssetResult, GetCatalogAssetOutputArgs } from "./getCatalogAsset";
+export const getCatalogAsset: typeof import("./getCatalogAsset").getCatalogAsset = null as any;
+export const getCatalogAssetOutput: typeof import("./getCatalogAsset").getCatalogAssetOutput = null as any;
+utilities.lazyLoad(exports, ["getCatalogAsset","getCatalogAssetOutput"], () => require("./getCatalogAsset"));
+
+export { GetCurrentUserArgs, GetCurrentUserResult } from "./getCurrentUser";
+export const getCurrentUser: typeof import("./getCurrentUser").getCurrentUser = null as any;
+export const getCurrentUserOutput: typeof import("./getCurrentUser").getCurrentUserOutput = null as any;
+utilities.lazyLoad(exports, ["getCurrentUser","getCurrentUserOutput"], () => require("./getCurrentUser"));
+
+export { GetLineageArgs, GetLineageResult, GetLineageOutputArgs } from "./getLineage";
+export const getLineage: typeof import("./getLineage").getLineage = null as any;
+export const getLineageOutput: typeof import("./getLineage").getLineageOutput = null as any;
+utilities.lazyLoad(exports, ["getLineage","getLineageOutput"], () => require("./getLineage"));
+
+export { GetMetadataFieldSuggestionsArgs, GetMetadataFieldSuggestionsResult, GetMetadataFieldSuggestionsOutputArgs } from "./getMetadataFieldSuggestions";
+export const getMetadataFieldSuggestions: typeof import("./getMetadataFieldSuggestions").getMetadataFieldSuggestions = null as any;
+export const getMetadataFieldSuggestionsOutput: typeof import("./getMetadataFieldSuggestions").getMetadataFieldSuggestionsOutput = null as any;
+utilities.lazyLoad(exports, ["getMetadataFieldSuggestions","getMetadataFieldSuggestionsOutput"], () => require("./getMetadataFieldSuggestions"));
+
+export { GetMetadataValueSuggestionsArgs, GetMetadataValueSuggestionsResult, GetMetadataValueSuggestionsOutputArgs } from "./getMetadataValueSuggestions";
+export const getMetadataValueSuggestions: typeof import("./getMetadataValueSuggestions").getMetadataValueSuggestions = null as any;
+export const getMetadataValueSuggestionsOutput: typeof import("./getMetadataValueSuggestions").getMetadataValueSuggestionsOutput = null as any;
+utilities.lazyLoad(exports, ["getMetadataValueSuggestions","getMetadataValueSuggestionsOutput"], () => require("./getMetadataValueSuggestions"));
+
+export { GetTagSuggestionsArgs, GetTagSuggestionsResult, GetTagSuggestionsOutputArgs } from "./getTagSuggestions";
+export const getTagSuggestions: typeof import("./getTagSuggestions").getTagSuggestions = null as any;
+export const getTagSuggestionsOutput: typeof import("./getTagSuggestions").getTagSuggestionsOutput = null as any;
+utilities.lazyLoad(exports, ["getTagSuggestions","getTagSuggestionsOutput"], () => require("./getTagSuggestions"));
+
 export { LineageArgs } from "./lineage";
 export type Lineage = import("./lineage").Lineage;
 export const Lineage: typeof import("./lineage").Lineage = null as any;
 utilities.lazyLoad(exports, ["Lineage"], () => require("./lineage"));
 
+export { LineageSetArgs } from "./lineageSet";
+export type LineageSet = import("./lineageSet").LineageSet;
+export const LineageSet: typeof import("./lineageSet").LineageSet = null as any;
+utilities.lazyLoad(exports, ["LineageSet"], () => require("./lineageSet"));
+
+export { ListAssetsArgs, ListAssetsResult, ListAssetsOutputArgs } from "./listAssets";
+export const listAssets: typeof import("./listAssets").listAssets = null as any;
+export const listAssetsOutput: typeof import("./listAssets").listAssetsOutput = null as any;
+utilities.lazyLoad(exports, ["listAssets","listAssetsOutput"], () => require("./listAssets"));
+
+export { ListManagedAssetsArgs, ListManagedAssetsResult, ListManagedAssetsOutputArgs } from "./listManagedAssets";
+export const listManagedAssets: typeof import("./listManagedAssets").listManagedAssets = null as any;
+export const listManagedAssetsOutput: typeof import("./listManagedAssets").listManagedAssetsOutput = null as any;
+utilities.lazyLoad(exports, ["listManagedAssets","listManagedAssetsOutput"], () => require("./listManagedAssets"));
+
+export { ListUsersArgs, ListUsersResult, ListUsersOutputArgs } from "./listUsers";
+export const listUsers: typeof import("./listUsers").listUsers = null as any;
+export const listUsersOutput: typeof import("./listUsers").listUsersOutput = null as any;
+utilities.lazyLoad(exports, ["listUsers","listUsersOutput"], () => require("./listUsers"));
+
+export { LookupAssetArgs, LookupAssetResult, LookupAssetOutputArgs } from "./lookupAsset";
+export const lookupAsset: typeof import("./lookupAsset").lookupAsset = null as any;
+export const lookupAssetOutput: typeof import("./lookupAsset").lookupAssetOutput = null as any;
+utilities.lazyLoad(exports, ["lookupAsset","lookupAssetOutput"], () => require("./lookupAsset"));
+
+export { MatchAssetsArgs, MatchAssetsResult, MatchAssetsOutputArgs } from "./matchAssets";
+export const matchAssets: typeof import("./matchAssets").matchAssets = null as any;
+export const matchAssetsOutput: typeof import("./matchAssets").matchAssetsOutput = null as any;
+utilities.lazyLoad(exports, ["matchAssets","matchAssetsOutput"], () => require("./matchAssets"));
+
 export { ProviderArgs } from "./provider";
 export type Provider = import("./provider").Provider;
 export const Provider: typeof import("./provider").Provider = null as any;
 utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
+export { SearchAssetsArgs, SearchAssetsResult, SearchAssetsOutputArgs } from "./searchAssets";
+export const searchAssets: typeof import("./searchAssets").searchAssets = null as any;
+export const searchAssetsOutput: typeof import("./searchAssets").searchAssetsOutput = null as any;
+utilities.lazyLoad(exports, ["searchAssets","searchAssetsOutput"], () => require("./searchAssets"));
+
+export { UpstreamLineageArgs } from "./upstreamLineage";
+export type UpstreamLineage = import("./upstreamLineage").UpstreamLineage;
+export const UpstreamLineage: typeof import("./upstreamLineage").UpstreamLineage = null as any;
+utilities.lazyLoad(exports, ["UpstreamLineage"], () => require("./upstreamLineage"));
+
 
 // Export sub-modules:
 import * as config from "./config";
@@ -36,8 +131,18 @@ const _module = {
         switch (type) {
             case "marmot:index:Asset":
                 return new Asset(name, <any>undefined, { urn })
+            case "marmot:index:AssetCollection":
+                return new AssetCollection(name, <any>undefined, { urn })
+            case "marmot:index:DataPipeline":
+                return new DataPipeline(name, <any>undefined, { urn })
+            case "marmot:index:DocumentationSet":
+                return new DocumentationSet(name, <any>undefined, { urn })
             case "marmot:index:Lineage":
                 return new Lineage(name, <any>undefined, { urn })
+            case "marmot:index:LineageSet":
+                return new LineageSet(name, <any>undefined, { urn })
+            case "marmot:index:UpstreamLineage":
+                return new UpstreamLineage(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export class LineageSet extends pulumi.CustomResource {
+    /**
+     * Get an existing LineageSet resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): LineageSet {
+        return new LineageSet(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'marmot:index:LineageSet';
+
+    /**
+     * Returns true if the given object is an instance of LineageSet.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is LineageSet {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === LineageSet.__pulumiType;
+    }
+
+    public readonly edges!: pulumi.Output<outputs.LineageSetEdge[]>;
+    public /*out*/ readonly results!: pulumi.Output<outputs.LineageSetEdgeResult[]>;
+
+    /**
+     * Create a LineageSet resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: LineageSetArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.edges === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'edges'");
+            }
+            resourceInputs["edges"] = args ? args.edges : undefined;
+            resourceInputs["results"] = undefined /*out*/;
+        } else {
+            resourceInputs["edges"] = undefined /*out*/;
+            resourceInputs["results"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(LineageSet.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a LineageSet resource.
+ */
+export interface LineageSetArgs {
+    edges: pulumi.Input<pulumi.Input<inputs.LineageSetEdgeArgs>[]>;
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function listAssets(args?: ListAssetsArgs, opts?: pulumi.InvokeOptions): Promise<ListAssetsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:listAssets", {
+        "pageSize": args.pageSize,
+        "parallelism": args.parallelism,
+    }, opts);
+}
+
+export interface ListAssetsArgs {
+    pageSize?: number;
+    parallelism?: number;
+}
+
+export interface ListAssetsResult {
+    readonly assets: outputs.AssetSummary[];
+    readonly count: number;
+    readonly total: number;
+}
+export function listAssetsOutput(args?: ListAssetsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ListAssetsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:listAssets", {
+        "pageSize": args.pageSize,
+        "parallelism": args.parallelism,
+    }, opts);
+}
+
+export interface ListAssetsOutputArgs {
+    pageSize?: pulumi.Input<number>;
+    parallelism?: pulumi.Input<number>;
+}
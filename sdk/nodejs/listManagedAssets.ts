@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function listManagedAssets(args: ListManagedAssetsArgs, opts?: pulumi.InvokeOptions): Promise<ListManagedAssetsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:listManagedAssets", {
+        "project": args.project,
+        "stack": args.stack,
+    }, opts);
+}
+
+export interface ListManagedAssetsArgs {
+    project?: string;
+    stack: string;
+}
+
+export interface ListManagedAssetsResult {
+    readonly assets: outputs.ManagedAsset[];
+}
+export function listManagedAssetsOutput(args: ListManagedAssetsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ListManagedAssetsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:listManagedAssets", {
+        "project": args.project,
+        "stack": args.stack,
+    }, opts);
+}
+
+export interface ListManagedAssetsOutputArgs {
+    project?: pulumi.Input<string>;
+    stack: pulumi.Input<string>;
+}
@@ -0,0 +1,55 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function listUsers(args?: ListUsersArgs, opts?: pulumi.InvokeOptions): Promise<ListUsersResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:listUsers", {
+        "active": args.active,
+        "limit": args.limit,
+        "maxResults": args.maxResults,
+        "offset": args.offset,
+        "query": args.query,
+        "roleIds": args.roleIds,
+    }, opts);
+}
+
+export interface ListUsersArgs {
+    active?: boolean;
+    limit?: number;
+    maxResults?: number;
+    offset?: number;
+    query?: string;
+    roleIds?: string[];
+}
+
+export interface ListUsersResult {
+    readonly total: number;
+    readonly users: outputs.User[];
+}
+export function listUsersOutput(args?: ListUsersOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ListUsersResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:listUsers", {
+        "active": args.active,
+        "limit": args.limit,
+        "maxResults": args.maxResults,
+        "offset": args.offset,
+        "query": args.query,
+        "roleIds": args.roleIds,
+    }, opts);
+}
+
+export interface ListUsersOutputArgs {
+    active?: pulumi.Input<boolean>;
+    limit?: pulumi.Input<number>;
+    maxResults?: pulumi.Input<number>;
+    offset?: pulumi.Input<number>;
+    query?: pulumi.Input<string>;
+    roleIds?: pulumi.Input<pulumi.Input<string>[]>;
+}
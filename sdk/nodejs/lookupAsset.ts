@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function lookupAsset(args: LookupAssetArgs, opts?: pulumi.InvokeOptions): Promise<LookupAssetResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:lookupAsset", {
+        "allowMissing": args.allowMissing,
+        "name": args.name,
+        "type": args.type,
+    }, opts);
+}
+
+export interface LookupAssetArgs {
+    allowMissing?: boolean;
+    name: string;
+    type: string;
+}
+
+export interface LookupAssetResult {
+    readonly asset?: outputs.CatalogAsset;
+}
+export function lookupAssetOutput(args: LookupAssetOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<LookupAssetResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:lookupAsset", {
+        "allowMissing": args.allowMissing,
+        "name": args.name,
+        "type": args.type,
+    }, opts);
+}
+
+export interface LookupAssetOutputArgs {
+    allowMissing?: pulumi.Input<boolean>;
+    name: pulumi.Input<string>;
+    type: pulumi.Input<string>;
+}
@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function matchAssets(args: MatchAssetsArgs, opts?: pulumi.InvokeOptions): Promise<MatchAssetsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:matchAssets", {
+        "pattern": args.pattern,
+        "type": args.type,
+    }, opts);
+}
+
+export interface MatchAssetsArgs {
+    pattern: string;
+    type: string;
+}
+
+export interface MatchAssetsResult {
+    readonly matches: outputs.AssetMatch[];
+    readonly mrns: string[];
+}
+export function matchAssetsOutput(args: MatchAssetsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<MatchAssetsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:matchAssets", {
+        "pattern": args.pattern,
+        "type": args.type,
+    }, opts);
+}
+
+export interface MatchAssetsOutputArgs {
+    pattern: pulumi.Input<string>;
+    type: pulumi.Input<string>;
+}
@@ -21,6 +21,7 @@ export class Provider extends pulumi.ProviderResource {
 
     public readonly apiKey!: pulumi.Output<string>;
     public readonly host!: pulumi.Output<string>;
+    public readonly strictTags!: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -39,8 +40,18 @@ export class Provider extends pulumi.ProviderResource {
             if ((!args || args.host === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'host'");
             }
+            resourceInputs["allowedTags"] = pulumi.output(args ? args.allowedTags : undefined).apply(JSON.stringify);
             resourceInputs["apiKey"] = args ? args.apiKey : undefined;
+            resourceInputs["batchCreates"] = pulumi.output(args ? args.batchCreates : undefined).apply(JSON.stringify);
+            resourceInputs["batchSize"] = pulumi.output(args ? args.batchSize : undefined).apply(JSON.stringify);
+            resourceInputs["batchWindowMs"] = pulumi.output(args ? args.batchWindowMs : undefined).apply(JSON.stringify);
+            resourceInputs["defaultMetadata"] = pulumi.output(args ? args.defaultMetadata : undefined).apply(JSON.stringify);
+            resourceInputs["defaultTags"] = pulumi.output(args ? args.defaultTags : undefined).apply(JSON.stringify);
+            resourceInputs["failFast"] = pulumi.output(args ? args.failFast : undefined).apply(JSON.stringify);
             resourceInputs["host"] = args ? args.host : undefined;
+            resourceInputs["ignoreMetadataKeys"] = pulumi.output(args ? args.ignoreMetadataKeys : undefined).apply(JSON.stringify);
+            resourceInputs["provenance"] = pulumi.output(args ? args.provenance : undefined).apply(JSON.stringify);
+            resourceInputs["strictTags"] = args ? args.strictTags : undefined;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
@@ -51,6 +62,16 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    allowedTags?: pulumi.Input<pulumi.Input<string>[]>;
     apiKey: pulumi.Input<string>;
+    batchCreates?: pulumi.Input<boolean>;
+    batchSize?: pulumi.Input<number>;
+    batchWindowMs?: pulumi.Input<number>;
+    defaultMetadata?: pulumi.Input<{[key: string]: any}>;
+    defaultTags?: pulumi.Input<pulumi.Input<string>[]>;
+    failFast?: pulumi.Input<boolean>;
     host: pulumi.Input<string>;
+    ignoreMetadataKeys?: pulumi.Input<pulumi.Input<string>[]>;
+    provenance?: pulumi.Input<boolean>;
+    strictTags?: pulumi.Input<string>;
 }
@@ -0,0 +1,64 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export function searchAssets(args?: SearchAssetsArgs, opts?: pulumi.InvokeOptions): Promise<SearchAssetsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("marmot:index:searchAssets", {
+        "calculateCounts": args.calculateCounts,
+        "limit": args.limit,
+        "maxResults": args.maxResults,
+        "offset": args.offset,
+        "query": args.query,
+        "services": args.services,
+        "tags": args.tags,
+        "types": args.types,
+    }, opts);
+}
+
+export interface SearchAssetsArgs {
+    calculateCounts?: boolean;
+    limit?: number;
+    maxResults?: number;
+    offset?: number;
+    query?: string;
+    services?: string[];
+    tags?: string[];
+    types?: string[];
+}
+
+export interface SearchAssetsResult {
+    readonly assets: outputs.CatalogAsset[];
+    readonly filters: outputs.AssetFilters;
+    readonly total: number;
+}
+export function searchAssetsOutput(args?: SearchAssetsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<SearchAssetsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("marmot:index:searchAssets", {
+        "calculateCounts": args.calculateCounts,
+        "limit": args.limit,
+        "maxResults": args.maxResults,
+        "offset": args.offset,
+        "query": args.query,
+        "services": args.services,
+        "tags": args.tags,
+        "types": args.types,
+    }, opts);
+}
+
+export interface SearchAssetsOutputArgs {
+    calculateCounts?: pulumi.Input<boolean>;
+    limit?: pulumi.Input<number>;
+    maxResults?: pulumi.Input<number>;
+    offset?: pulumi.Input<number>;
+    query?: pulumi.Input<string>;
+    services?: pulumi.Input<pulumi.Input<string>[]>;
+    tags?: pulumi.Input<pulumi.Input<string>[]>;
+    types?: pulumi.Input<pulumi.Input<string>[]>;
+}
@@ -5,6 +5,20 @@ import * as pulumi from "@pulumi/pulumi";
 import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
+export interface AssetCollectionItemArgs {
+    description?: pulumi.Input<string>;
+    environments?: pulumi.Input<{[key: string]: pulumi.Input<inputs.AssetEnvironmentArgs>}>;
+    externalLinks?: pulumi.Input<pulumi.Input<inputs.ExternalLinkArgs>[]>;
+    ignoreMetadataKeys?: pulumi.Input<pulumi.Input<string>[]>;
+    metadata?: pulumi.Input<{[key: string]: any}>;
+    name: pulumi.Input<string>;
+    schema?: pulumi.Input<{[key: string]: any}>;
+    services: pulumi.Input<pulumi.Input<string>[]>;
+    sources?: pulumi.Input<pulumi.Input<inputs.AssetSourceArgs>[]>;
+    tags?: pulumi.Input<pulumi.Input<string>[]>;
+    type: pulumi.Input<string>;
+}
+
 export interface AssetEnvironmentArgs {
     metadata?: pulumi.Input<{[key: string]: any}>;
     name: pulumi.Input<string>;
@@ -22,3 +36,10 @@ export interface ExternalLinkArgs {
     name: pulumi.Input<string>;
     url: pulumi.Input<string>;
 }
+
+export interface LineageSetEdgeArgs {
+    jobMrn?: pulumi.Input<string>;
+    source: pulumi.Input<string>;
+    target: pulumi.Input<string>;
+}
+
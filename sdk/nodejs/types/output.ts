@@ -5,21 +5,162 @@ import * as pulumi from "@pulumi/pulumi";
 import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
+export interface AssetCollectionItem {
+    description?: string;
+    environments?: {[key: string]: outputs.AssetEnvironment};
+    externalLinks?: outputs.ExternalLink[];
+    ignoreMetadataKeys?: string[];
+    metadata?: {[key: string]: any};
+    name: string;
+    schema?: {[key: string]: any};
+    services: string[];
+    sources?: outputs.AssetSource[];
+    tags?: string[];
+    type: string;
+}
+
 export interface AssetEnvironment {
     metadata?: {[key: string]: any};
     name: string;
     path: string;
 }
 
+export interface AssetFilters {
+    services: {[key: string]: number};
+    tags: {[key: string]: number};
+    types: {[key: string]: number};
+}
+
+export interface AssetMatch {
+    mrn: string;
+    name: string;
+    resourceId: string;
+}
+
 export interface AssetSource {
     name: string;
     priority?: number;
     properties?: {[key: string]: any};
 }
 
+export interface AssetSummary {
+    mrn: string;
+    name: string;
+    parentMrn?: string;
+    resourceId: string;
+    services: string[];
+    tags?: string[];
+    type: string;
+    updatedAt: string;
+}
+
+export interface CatalogAsset {
+    createdAt: string;
+    createdBy: string;
+    description?: string;
+    environments?: {[key: string]: outputs.AssetEnvironment};
+    externalLinks?: outputs.ExternalLink[];
+    lastSyncAt?: string;
+    metadata?: {[key: string]: any};
+    mrn: string;
+    name: string;
+    parentMrn?: string;
+    resourceId: string;
+    schema?: {[key: string]: any};
+    services: string[];
+    sources?: outputs.AssetSource[];
+    tags?: string[];
+    type: string;
+    updatedAt: string;
+}
+
+export interface DocumentResult {
+    error?: string;
+    hash: string;
+    mrn: string;
+    status: string;
+}
+
 export interface ExternalLink {
     icon?: string;
     name: string;
     url: string;
 }
 
+export interface LineageEdge {
+    jobMrn?: string;
+    resourceId: string;
+    source: string;
+    target: string;
+    type?: string;
+}
+
+export interface LineageNode {
+    asset?: outputs.CatalogAsset;
+    depth: number;
+    resourceId: string;
+    type: string;
+}
+
+export interface LineageSetEdge {
+    jobMrn?: string;
+    source: string;
+    target: string;
+}
+
+export interface LineageSetEdgeResult {
+    jobMrn?: string;
+    resourceId: string;
+    source: string;
+    status: string;
+    target: string;
+    type?: string;
+}
+
+export interface ManagedAsset {
+    id: string;
+    mrn: string;
+    name: string;
+    type: string;
+    urn: string;
+}
+
+export interface MetadataFieldSuggestion {
+    count: number;
+    example?: any;
+    field: string;
+    pathParts: string[];
+    type: string;
+    types: string[];
+}
+
+export interface MetadataValueSuggestion {
+    count: number;
+    exampleMrn?: string;
+    value: string;
+}
+
+export interface UpstreamEdge {
+    created?: boolean;
+    jobMrn?: string;
+    resourceId: string;
+    source: string;
+}
+
+export interface User {
+    active: boolean;
+    createdAt?: string;
+    name: string;
+    resourceId: string;
+    roles: outputs.UserRole[];
+    updatedAt?: string;
+    username: string;
+}
+
+export interface UserRole {
+    description?: string;
+    id: string;
+    name: string;
+    permissions: string[];
+}
+
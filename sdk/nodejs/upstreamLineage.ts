@@ -0,0 +1,83 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export class UpstreamLineage extends pulumi.CustomResource {
+    /**
+     * Get an existing UpstreamLineage resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): UpstreamLineage {
+        return new UpstreamLineage(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'marmot:index:UpstreamLineage';
+
+    /**
+     * Returns true if the given object is an instance of UpstreamLineage.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is UpstreamLineage {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === UpstreamLineage.__pulumiType;
+    }
+
+    public /*out*/ readonly edges!: pulumi.Output<{[key: string]: outputs.UpstreamEdge}>;
+    public readonly jobMrn!: pulumi.Output<string | undefined>;
+    public readonly sources!: pulumi.Output<string[]>;
+    public readonly target!: pulumi.Output<string>;
+    public /*out*/ readonly targetId!: pulumi.Output<string>;
+
+    /**
+     * Create a UpstreamLineage resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: UpstreamLineageArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.sources === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'sources'");
+            }
+            if ((!args || args.target === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'target'");
+            }
+            resourceInputs["jobMrn"] = args ? args.jobMrn : undefined;
+            resourceInputs["sources"] = args ? args.sources : undefined;
+            resourceInputs["target"] = args ? args.target : undefined;
+            resourceInputs["edges"] = undefined /*out*/;
+            resourceInputs["targetId"] = undefined /*out*/;
+        } else {
+            resourceInputs["edges"] = undefined /*out*/;
+            resourceInputs["jobMrn"] = undefined /*out*/;
+            resourceInputs["sources"] = undefined /*out*/;
+            resourceInputs["target"] = undefined /*out*/;
+            resourceInputs["targetId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(UpstreamLineage.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a UpstreamLineage resource.
+ */
+export interface UpstreamLineageArgs {
+    jobMrn?: pulumi.Input<string>;
+    sources: pulumi.Input<pulumi.Input<string>[]>;
+    target: pulumi.Input<string>;
+}
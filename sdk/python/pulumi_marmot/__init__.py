@@ -6,8 +6,27 @@ from . import _utilities
 import typing
 # Export this package's modules as members:
 from .asset import *
+from .asset_collection import *
+from .data_pipeline import *
+from .documentation_set import *
+from .get_asset_summary import *
+from .get_auth_config import *
+from .get_catalog_asset import *
+from .get_current_user import *
+from .get_lineage import *
+from .get_metadata_field_suggestions import *
+from .get_metadata_value_suggestions import *
+from .get_tag_suggestions import *
 from .lineage import *
+from .lineage_set import *
+from .list_assets import *
+from .list_managed_assets import *
+from .list_users import *
+from .lookup_asset import *
+from .match_assets import *
 from .provider import *
+from .search_assets import *
+from .upstream_lineage import *
 from ._inputs import *
 from . import outputs
 
@@ -27,7 +46,12 @@ _utilities.register(
   "fqn": "pulumi_marmot",
   "classes": {
    "marmot:index:Asset": "Asset",
-   "marmot:index:Lineage": "Lineage"
+   "marmot:index:AssetCollection": "AssetCollection",
+   "marmot:index:DataPipeline": "DataPipeline",
+   "marmot:index:DocumentationSet": "DocumentationSet",
+   "marmot:index:Lineage": "Lineage",
+   "marmot:index:LineageSet": "LineageSet",
+   "marmot:index:UpstreamLineage": "UpstreamLineage"
   }
  }
 ]
@@ -15,16 +15,170 @@ else:
 from . import _utilities
 
 __all__ = [
+    'AssetCollectionItemArgs',
+    'AssetCollectionItemArgsDict',
     'AssetEnvironmentArgs',
     'AssetEnvironmentArgsDict',
     'AssetSourceArgs',
     'AssetSourceArgsDict',
     'ExternalLinkArgs',
     'ExternalLinkArgsDict',
+    'LineageSetEdgeArgs',
+    'LineageSetEdgeArgsDict',
 ]
 
 MYPY = False
 
+if not MYPY:
+    class AssetCollectionItemArgsDict(TypedDict):
+        name: pulumi.Input[str]
+        services: pulumi.Input[Sequence[pulumi.Input[str]]]
+        type: pulumi.Input[str]
+        description: NotRequired[pulumi.Input[str]]
+        environments: NotRequired[pulumi.Input[Mapping[str, pulumi.Input['AssetEnvironmentArgsDict']]]]
+        external_links: NotRequired[pulumi.Input[Sequence[pulumi.Input['ExternalLinkArgsDict']]]]
+        ignore_metadata_keys: NotRequired[pulumi.Input[Sequence[pulumi.Input[str]]]]
+        metadata: NotRequired[pulumi.Input[Mapping[str, Any]]]
+        schema: NotRequired[pulumi.Input[Mapping[str, Any]]]
+        sources: NotRequired[pulumi.Input[Sequence[pulumi.Input['AssetSourceArgsDict']]]]
+        tags: NotRequired[pulumi.Input[Sequence[pulumi.Input[str]]]]
+elif False:
+    AssetCollectionItemArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class AssetCollectionItemArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[str],
+                 services: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 type: pulumi.Input[str],
+                 description: Optional[pulumi.Input[str]] = None,
+                 environments: Optional[pulumi.Input[Mapping[str, pulumi.Input['AssetEnvironmentArgs']]]] = None,
+                 external_links: Optional[pulumi.Input[Sequence[pulumi.Input['ExternalLinkArgs']]]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 schema: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 sources: Optional[pulumi.Input[Sequence[pulumi.Input['AssetSourceArgs']]]] = None,
+                 tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "services", services)
+        pulumi.set(__self__, "type", type)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if environments is not None:
+            pulumi.set(__self__, "environments", environments)
+        if external_links is not None:
+            pulumi.set(__self__, "external_links", external_links)
+        if ignore_metadata_keys is not None:
+            pulumi.set(__self__, "ignore_metadata_keys", ignore_metadata_keys)
+        if metadata is not None:
+            pulumi.set(__self__, "metadata", metadata)
+        if schema is not None:
+            pulumi.set(__self__, "schema", schema)
+        if sources is not None:
+            pulumi.set(__self__, "sources", sources)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def services(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        return pulumi.get(self, "services")
+
+    @services.setter
+    def services(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "services", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "description", value)
+
+    @property
+    @pulumi.getter
+    def environments(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['AssetEnvironmentArgs']]]]:
+        return pulumi.get(self, "environments")
+
+    @environments.setter
+    def environments(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['AssetEnvironmentArgs']]]]):
+        pulumi.set(self, "environments", value)
+
+    @property
+    @pulumi.getter(name="externalLinks")
+    def external_links(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ExternalLinkArgs']]]]:
+        return pulumi.get(self, "external_links")
+
+    @external_links.setter
+    def external_links(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ExternalLinkArgs']]]]):
+        pulumi.set(self, "external_links", value)
+
+    @property
+    @pulumi.getter(name="ignoreMetadataKeys")
+    def ignore_metadata_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        return pulumi.get(self, "ignore_metadata_keys")
+
+    @ignore_metadata_keys.setter
+    def ignore_metadata_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "ignore_metadata_keys", value)
+
+    @property
+    @pulumi.getter
+    def metadata(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        return pulumi.get(self, "metadata")
+
+    @metadata.setter
+    def metadata(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "metadata", value)
+
+    @property
+    @pulumi.getter
+    def schema(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        return pulumi.get(self, "schema")
+
+    @schema.setter
+    def schema(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "schema", value)
+
+    @property
+    @pulumi.getter
+    def sources(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AssetSourceArgs']]]]:
+        return pulumi.get(self, "sources")
+
+    @sources.setter
+    def sources(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AssetSourceArgs']]]]):
+        pulumi.set(self, "sources", value)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "tags", value)
+
+
 if not MYPY:
     class AssetEnvironmentArgsDict(TypedDict):
         name: pulumi.Input[str]
@@ -167,3 +321,50 @@ class ExternalLinkArgs:
         pulumi.set(self, "icon", value)
 
 
+if not MYPY:
+    class LineageSetEdgeArgsDict(TypedDict):
+        source: pulumi.Input[str]
+        target: pulumi.Input[str]
+        job_mrn: NotRequired[pulumi.Input[str]]
+elif False:
+    LineageSetEdgeArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class LineageSetEdgeArgs:
+    def __init__(__self__, *,
+                 source: pulumi.Input[str],
+                 target: pulumi.Input[str],
+                 job_mrn: Optional[pulumi.Input[str]] = None):
+        pulumi.set(__self__, "source", source)
+        pulumi.set(__self__, "target", target)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+
+    @property
+    @pulumi.getter
+    def source(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: pulumi.Input[str]):
+        pulumi.set(self, "source", value)
+
+    @property
+    @pulumi.getter
+    def target(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "target")
+
+    @target.setter
+    def target(self, value: pulumi.Input[str]):
+        pulumi.set(self, "target", value)
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "job_mrn")
+
+    @job_mrn.setter
+    def job_mrn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "job_mrn", value)
+
+
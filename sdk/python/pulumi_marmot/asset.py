@@ -27,8 +27,12 @@ class AssetArgs:
                  description: Optional[pulumi.Input[str]] = None,
                  environments: Optional[pulumi.Input[Mapping[str, pulumi.Input['AssetEnvironmentArgs']]]] = None,
                  external_links: Optional[pulumi.Input[Sequence[pulumi.Input['ExternalLinkArgs']]]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  schema: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 schema_file: Optional[pulumi.Input[str]] = None,
+                 schema_format: Optional[pulumi.Input[str]] = None,
+                 schema_message: Optional[pulumi.Input[str]] = None,
                  sources: Optional[pulumi.Input[Sequence[pulumi.Input['AssetSourceArgs']]]] = None,
                  tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
         """
@@ -43,10 +47,18 @@ class AssetArgs:
             pulumi.set(__self__, "environments", environments)
         if external_links is not None:
             pulumi.set(__self__, "external_links", external_links)
+        if ignore_metadata_keys is not None:
+            pulumi.set(__self__, "ignore_metadata_keys", ignore_metadata_keys)
         if metadata is not None:
             pulumi.set(__self__, "metadata", metadata)
         if schema is not None:
             pulumi.set(__self__, "schema", schema)
+        if schema_file is not None:
+            pulumi.set(__self__, "schema_file", schema_file)
+        if schema_format is not None:
+            pulumi.set(__self__, "schema_format", schema_format)
+        if schema_message is not None:
+            pulumi.set(__self__, "schema_message", schema_message)
         if sources is not None:
             pulumi.set(__self__, "sources", sources)
         if tags is not None:
@@ -106,6 +118,15 @@ class AssetArgs:
     def external_links(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ExternalLinkArgs']]]]):
         pulumi.set(self, "external_links", value)
 
+    @property
+    @pulumi.getter(name="ignoreMetadataKeys")
+    def ignore_metadata_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        return pulumi.get(self, "ignore_metadata_keys")
+
+    @ignore_metadata_keys.setter
+    def ignore_metadata_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "ignore_metadata_keys", value)
+
     @property
     @pulumi.getter
     def metadata(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
@@ -124,6 +145,33 @@ class AssetArgs:
     def schema(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
         pulumi.set(self, "schema", value)
 
+    @property
+    @pulumi.getter(name="schemaFile")
+    def schema_file(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "schema_file")
+
+    @schema_file.setter
+    def schema_file(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "schema_file", value)
+
+    @property
+    @pulumi.getter(name="schemaFormat")
+    def schema_format(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "schema_format")
+
+    @schema_format.setter
+    def schema_format(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "schema_format", value)
+
+    @property
+    @pulumi.getter(name="schemaMessage")
+    def schema_message(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "schema_message")
+
+    @schema_message.setter
+    def schema_message(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "schema_message", value)
+
     @property
     @pulumi.getter
     def sources(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AssetSourceArgs']]]]:
@@ -151,9 +199,13 @@ class Asset(pulumi.CustomResource):
                  description: Optional[pulumi.Input[str]] = None,
                  environments: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['AssetEnvironmentArgs']]]]] = None,
                  external_links: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ExternalLinkArgs']]]]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  schema: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 schema_file: Optional[pulumi.Input[str]] = None,
+                 schema_format: Optional[pulumi.Input[str]] = None,
+                 schema_message: Optional[pulumi.Input[str]] = None,
                  services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  sources: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssetSourceArgs']]]]] = None,
                  tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
@@ -190,9 +242,13 @@ class Asset(pulumi.CustomResource):
                  description: Optional[pulumi.Input[str]] = None,
                  environments: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['AssetEnvironmentArgs']]]]] = None,
                  external_links: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ExternalLinkArgs']]]]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  schema: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 schema_file: Optional[pulumi.Input[str]] = None,
+                 schema_format: Optional[pulumi.Input[str]] = None,
+                 schema_message: Optional[pulumi.Input[str]] = None,
                  services: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  sources: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssetSourceArgs']]]]] = None,
                  tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
@@ -209,11 +265,15 @@ class Asset(pulumi.CustomResource):
             __props__.__dict__["description"] = description
             __props__.__dict__["environments"] = environments
             __props__.__dict__["external_links"] = external_links
+            __props__.__dict__["ignore_metadata_keys"] = ignore_metadata_keys
             __props__.__dict__["metadata"] = metadata
             if name is None and not opts.urn:
                 raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
             __props__.__dict__["schema"] = schema
+            __props__.__dict__["schema_file"] = schema_file
+            __props__.__dict__["schema_format"] = schema_format
+            __props__.__dict__["schema_message"] = schema_message
             if services is None and not opts.urn:
                 raise TypeError("Missing required property 'services'")
             __props__.__dict__["services"] = services
@@ -255,12 +315,16 @@ class Asset(pulumi.CustomResource):
         __props__.__dict__["description"] = None
         __props__.__dict__["environments"] = None
         __props__.__dict__["external_links"] = None
+        __props__.__dict__["ignore_metadata_keys"] = None
         __props__.__dict__["last_sync_at"] = None
         __props__.__dict__["metadata"] = None
         __props__.__dict__["mrn"] = None
         __props__.__dict__["name"] = None
         __props__.__dict__["resource_id"] = None
         __props__.__dict__["schema"] = None
+        __props__.__dict__["schema_file"] = None
+        __props__.__dict__["schema_format"] = None
+        __props__.__dict__["schema_message"] = None
         __props__.__dict__["services"] = None
         __props__.__dict__["sources"] = None
         __props__.__dict__["tags"] = None
@@ -293,6 +357,11 @@ class Asset(pulumi.CustomResource):
     def external_links(self) -> pulumi.Output[Optional[Sequence['outputs.ExternalLink']]]:
         return pulumi.get(self, "external_links")
 
+    @property
+    @pulumi.getter(name="ignoreMetadataKeys")
+    def ignore_metadata_keys(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        return pulumi.get(self, "ignore_metadata_keys")
+
     @property
     @pulumi.getter(name="lastSyncAt")
     def last_sync_at(self) -> pulumi.Output[Optional[str]]:
@@ -323,6 +392,21 @@ class Asset(pulumi.CustomResource):
     def schema(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
         return pulumi.get(self, "schema")
 
+    @property
+    @pulumi.getter(name="schemaFile")
+    def schema_file(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "schema_file")
+
+    @property
+    @pulumi.getter(name="schemaFormat")
+    def schema_format(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "schema_format")
+
+    @property
+    @pulumi.getter(name="schemaMessage")
+    def schema_message(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "schema_message")
+
     @property
     @pulumi.getter
     def services(self) -> pulumi.Output[Sequence[str]]:
@@ -0,0 +1,152 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['AssetCollectionArgs', 'AssetCollection']
+
+@pulumi.input_type
+class AssetCollectionArgs:
+    def __init__(__self__, *,
+                 assets: pulumi.Input[Mapping[str, pulumi.Input['AssetCollectionItemArgs']]],
+                 config: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        The set of arguments for constructing a AssetCollection resource.
+        """
+        pulumi.set(__self__, "assets", assets)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+
+    @property
+    @pulumi.getter
+    def assets(self) -> pulumi.Input[Mapping[str, pulumi.Input['AssetCollectionItemArgs']]]:
+        return pulumi.get(self, "assets")
+
+    @assets.setter
+    def assets(self, value: pulumi.Input[Mapping[str, pulumi.Input['AssetCollectionItemArgs']]]):
+        pulumi.set(self, "assets", value)
+
+    @property
+    @pulumi.getter
+    def config(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "config", value)
+
+
+class AssetCollection(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 assets: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]]]] = None,
+                 config: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        """
+        Create a AssetCollection resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: AssetCollectionArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a AssetCollection resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param AssetCollectionArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(AssetCollectionArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 assets: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]]]] = None,
+                 config: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = AssetCollectionArgs.__new__(AssetCollectionArgs)
+
+            if assets is None and not opts.urn:
+                raise TypeError("Missing required property 'assets'")
+            __props__.__dict__["assets"] = assets
+            __props__.__dict__["config"] = config
+            __props__.__dict__["mrns"] = None
+            __props__.__dict__["resource_ids"] = None
+        super(AssetCollection, __self__).__init__(
+            'marmot:index:AssetCollection',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'AssetCollection':
+        """
+        Get an existing AssetCollection resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = AssetCollectionArgs.__new__(AssetCollectionArgs)
+
+        __props__.__dict__["assets"] = None
+        __props__.__dict__["config"] = None
+        __props__.__dict__["mrns"] = None
+        __props__.__dict__["resource_ids"] = None
+        return AssetCollection(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def assets(self) -> pulumi.Output[Mapping[str, 'outputs.AssetCollectionItem']]:
+        return pulumi.get(self, "assets")
+
+    @property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        return pulumi.get(self, "config")
+
+    @property
+    @pulumi.getter
+    def mrns(self) -> pulumi.Output[Mapping[str, str]]:
+        return pulumi.get(self, "mrns")
+
+    @property
+    @pulumi.getter(name="resourceIds")
+    def resource_ids(self) -> pulumi.Output[Mapping[str, str]]:
+        return pulumi.get(self, "resource_ids")
+
@@ -14,7 +14,27 @@ else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
 
+allowedTags: Optional[str]
+
 apiKey: Optional[str]
 
+batchCreates: Optional[bool]
+
+batchSize: Optional[int]
+
+batchWindowMs: Optional[int]
+
+defaultMetadata: Optional[str]
+
+defaultTags: Optional[str]
+
+failFast: Optional[bool]
+
 host: Optional[str]
 
+ignoreMetadataKeys: Optional[str]
+
+provenance: Optional[bool]
+
+strictTags: Optional[str]
+
@@ -20,11 +20,51 @@ __config__ = pulumi.Config('marmot')
 
 
 class _ExportableConfig(types.ModuleType):
+    @property
+    def allowed_tags(self) -> Optional[str]:
+        return __config__.get('allowedTags')
+
     @property
     def api_key(self) -> Optional[str]:
         return __config__.get('apiKey')
 
+    @property
+    def batch_creates(self) -> Optional[bool]:
+        return __config__.get_bool('batchCreates')
+
+    @property
+    def batch_size(self) -> Optional[int]:
+        return __config__.get_int('batchSize')
+
+    @property
+    def batch_window_ms(self) -> Optional[int]:
+        return __config__.get_int('batchWindowMs')
+
+    @property
+    def default_metadata(self) -> Optional[str]:
+        return __config__.get('defaultMetadata')
+
+    @property
+    def default_tags(self) -> Optional[str]:
+        return __config__.get('defaultTags')
+
+    @property
+    def fail_fast(self) -> Optional[bool]:
+        return __config__.get_bool('failFast')
+
     @property
     def host(self) -> Optional[str]:
         return __config__.get('host')
 
+    @property
+    def ignore_metadata_keys(self) -> Optional[str]:
+        return __config__.get('ignoreMetadataKeys')
+
+    @property
+    def provenance(self) -> Optional[bool]:
+        return __config__.get_bool('provenance')
+
+    @property
+    def strict_tags(self) -> Optional[str]:
+        return __config__.get('strictTags')
+
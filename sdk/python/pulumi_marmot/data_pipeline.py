@@ -0,0 +1,190 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['DataPipelineArgs', 'DataPipeline']
+
+@pulumi.input_type
+class DataPipelineArgs:
+    def __init__(__self__, *,
+                 input_mrns: Optional[pulumi.Input[Sequence[str]]] = None,
+                 inputs: Optional[pulumi.Input[Sequence[pulumi.Input['AssetCollectionItemArgs']]]] = None,
+                 job: Optional[pulumi.Input['AssetCollectionItemArgs']] = None,
+                 job_mrn: Optional[pulumi.Input[str]] = None,
+                 output_mrns: Optional[pulumi.Input[Sequence[str]]] = None,
+                 outputs: Optional[pulumi.Input[Sequence[pulumi.Input['AssetCollectionItemArgs']]]] = None):
+        """
+        The set of arguments for constructing a DataPipeline resource.
+        """
+        if input_mrns is not None:
+            pulumi.set(__self__, "input_mrns", input_mrns)
+        if inputs is not None:
+            pulumi.set(__self__, "inputs", inputs)
+        if job is not None:
+            pulumi.set(__self__, "job", job)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+        if output_mrns is not None:
+            pulumi.set(__self__, "output_mrns", output_mrns)
+        if outputs is not None:
+            pulumi.set(__self__, "outputs", outputs)
+
+    @property
+    @pulumi.getter(name="inputMrns")
+    def input_mrns(self) -> Optional[pulumi.Input[Sequence[str]]]:
+        return pulumi.get(self, "input_mrns")
+
+    @input_mrns.setter
+    def input_mrns(self, value: Optional[pulumi.Input[Sequence[str]]]):
+        pulumi.set(self, "input_mrns", value)
+
+    @property
+    @pulumi.getter
+    def inputs(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AssetCollectionItemArgs']]]]:
+        return pulumi.get(self, "inputs")
+
+    @inputs.setter
+    def inputs(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AssetCollectionItemArgs']]]]):
+        pulumi.set(self, "inputs", value)
+
+    @property
+    @pulumi.getter
+    def job(self) -> Optional[pulumi.Input['AssetCollectionItemArgs']]:
+        return pulumi.get(self, "job")
+
+    @job.setter
+    def job(self, value: Optional[pulumi.Input['AssetCollectionItemArgs']]):
+        pulumi.set(self, "job", value)
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "job_mrn")
+
+    @job_mrn.setter
+    def job_mrn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "job_mrn", value)
+
+    @property
+    @pulumi.getter(name="outputMrns")
+    def output_mrns(self) -> Optional[pulumi.Input[Sequence[str]]]:
+        return pulumi.get(self, "output_mrns")
+
+    @output_mrns.setter
+    def output_mrns(self, value: Optional[pulumi.Input[Sequence[str]]]):
+        pulumi.set(self, "output_mrns", value)
+
+    @property
+    @pulumi.getter
+    def outputs(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['AssetCollectionItemArgs']]]]:
+        return pulumi.get(self, "outputs")
+
+    @outputs.setter
+    def outputs(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['AssetCollectionItemArgs']]]]):
+        pulumi.set(self, "outputs", value)
+
+
+class DataPipeline(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 input_mrns: Optional[pulumi.Input[Sequence[str]]] = None,
+                 inputs: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]]]] = None,
+                 job: Optional[pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]] = None,
+                 job_mrn: Optional[pulumi.Input[str]] = None,
+                 output_mrns: Optional[pulumi.Input[Sequence[str]]] = None,
+                 outputs: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]]]] = None,
+                 __props__=None):
+        """
+        Create a DataPipeline resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[DataPipelineArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a DataPipeline resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param DataPipelineArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DataPipelineArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 input_mrns: Optional[pulumi.Input[Sequence[str]]] = None,
+                 inputs: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]]]] = None,
+                 job: Optional[pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]] = None,
+                 job_mrn: Optional[pulumi.Input[str]] = None,
+                 output_mrns: Optional[pulumi.Input[Sequence[str]]] = None,
+                 outputs: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['AssetCollectionItemArgs']]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DataPipelineArgs.__new__(DataPipelineArgs)
+
+            __props__.__dict__["input_mrns"] = input_mrns
+            __props__.__dict__["inputs"] = inputs
+            __props__.__dict__["job"] = job
+            __props__.__dict__["job_mrn"] = job_mrn
+            __props__.__dict__["output_mrns"] = output_mrns
+            __props__.__dict__["outputs"] = outputs
+            __props__.__dict__["edges"] = None
+        super(DataPipeline, __self__).__init__(
+            'marmot:index:DataPipeline',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def edges(self) -> pulumi.Output[Sequence[Mapping[str, str]]]:
+        return pulumi.get(self, "edges")
+
+    @property
+    @pulumi.getter(name="inputMrns")
+    def input_mrns(self) -> pulumi.Output[Sequence[str]]:
+        return pulumi.get(self, "input_mrns")
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "job_mrn")
+
+    @property
+    @pulumi.getter(name="outputMrns")
+    def output_mrns(self) -> pulumi.Output[Sequence[str]]:
+        return pulumi.get(self, "output_mrns")
+
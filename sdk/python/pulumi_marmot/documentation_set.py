@@ -0,0 +1,186 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = ['DocumentationSetArgs', 'DocumentationSet']
+
+@pulumi.input_type
+class DocumentationSetArgs:
+    def __init__(__self__, *,
+                 directory: pulumi.Input[str],
+                 global_docs: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 mrn_template: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a DocumentationSet resource.
+        """
+        pulumi.set(__self__, "directory", directory)
+        if global_docs is not None:
+            pulumi.set(__self__, "global_docs", global_docs)
+        if mrn_template is not None:
+            pulumi.set(__self__, "mrn_template", mrn_template)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+
+    @property
+    @pulumi.getter
+    def directory(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "directory")
+
+    @directory.setter
+    def directory(self, value: pulumi.Input[str]):
+        pulumi.set(self, "directory", value)
+
+    @property
+    @pulumi.getter(name="globalDocs")
+    def global_docs(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]:
+        return pulumi.get(self, "global_docs")
+
+    @global_docs.setter
+    def global_docs(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]]):
+        pulumi.set(self, "global_docs", value)
+
+    @property
+    @pulumi.getter(name="mrnTemplate")
+    def mrn_template(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "mrn_template")
+
+    @mrn_template.setter
+    def mrn_template(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "mrn_template", value)
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source", value)
+
+
+class DocumentationSet(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 directory: Optional[pulumi.Input[str]] = None,
+                 global_docs: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 mrn_template: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Create a DocumentationSet resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: DocumentationSetArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a DocumentationSet resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param DocumentationSetArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DocumentationSetArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 directory: Optional[pulumi.Input[str]] = None,
+                 global_docs: Optional[pulumi.Input[Mapping[str, pulumi.Input[Sequence[pulumi.Input[str]]]]]] = None,
+                 mrn_template: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DocumentationSetArgs.__new__(DocumentationSetArgs)
+
+            if directory is None and not opts.urn:
+                raise TypeError("Missing required property 'directory'")
+            __props__.__dict__["directory"] = directory
+            __props__.__dict__["global_docs"] = global_docs
+            __props__.__dict__["mrn_template"] = mrn_template
+            __props__.__dict__["source"] = source
+            __props__.__dict__["documents"] = None
+        super(DocumentationSet, __self__).__init__(
+            'marmot:index:DocumentationSet',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'DocumentationSet':
+        """
+        Get an existing DocumentationSet resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = DocumentationSetArgs.__new__(DocumentationSetArgs)
+
+        __props__.__dict__["directory"] = None
+        __props__.__dict__["documents"] = None
+        __props__.__dict__["global_docs"] = None
+        __props__.__dict__["mrn_template"] = None
+        __props__.__dict__["source"] = None
+        return DocumentationSet(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def directory(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "directory")
+
+    @property
+    @pulumi.getter
+    def documents(self) -> pulumi.Output[Mapping[str, 'outputs.DocumentResult']]:
+        return pulumi.get(self, "documents")
+
+    @property
+    @pulumi.getter(name="globalDocs")
+    def global_docs(self) -> pulumi.Output[Optional[Mapping[str, Sequence[str]]]]:
+        return pulumi.get(self, "global_docs")
+
+    @property
+    @pulumi.getter(name="mrnTemplate")
+    def mrn_template(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "mrn_template")
+
+    @property
+    @pulumi.getter
+    def source(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "source")
+
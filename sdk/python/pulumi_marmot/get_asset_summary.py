@@ -0,0 +1,109 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetAssetSummaryResult',
+    'AwaitableGetAssetSummaryResult',
+    'get_asset_summary',
+    'get_asset_summary_output',
+]
+
+@pulumi.output_type
+class GetAssetSummaryResult:
+    def __init__(__self__, services=None, tags=None, total=None, types=None):
+        if services and not isinstance(services, dict):
+            raise TypeError("Expected argument 'services' to be a dict")
+        pulumi.set(__self__, "services", services)
+        if tags and not isinstance(tags, dict):
+            raise TypeError("Expected argument 'tags' to be a dict")
+        pulumi.set(__self__, "tags", tags)
+        if total and not isinstance(total, int):
+            raise TypeError("Expected argument 'total' to be a int")
+        pulumi.set(__self__, "total", total)
+        if types and not isinstance(types, dict):
+            raise TypeError("Expected argument 'types' to be a dict")
+        pulumi.set(__self__, "types", types)
+
+    @property
+    @pulumi.getter
+    def services(self) -> Mapping[str, int]:
+        return pulumi.get(self, "services")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Mapping[str, int]:
+        return pulumi.get(self, "tags")
+
+    @property
+    @pulumi.getter
+    def total(self) -> int:
+        return pulumi.get(self, "total")
+
+    @property
+    @pulumi.getter
+    def types(self) -> Mapping[str, int]:
+        return pulumi.get(self, "types")
+
+
+class AwaitableGetAssetSummaryResult(GetAssetSummaryResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetAssetSummaryResult(
+            services=self.services,
+            tags=self.tags,
+            total=self.total,
+            types=self.types)
+
+
+def get_asset_summary(min_service_counts: Optional[Mapping[str, int]] = None,
+                      min_tag_counts: Optional[Mapping[str, int]] = None,
+                      min_type_counts: Optional[Mapping[str, int]] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetAssetSummaryResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['minServiceCounts'] = min_service_counts
+    __args__['minTagCounts'] = min_tag_counts
+    __args__['minTypeCounts'] = min_type_counts
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getAssetSummary', __args__, opts=opts, typ=GetAssetSummaryResult).value
+
+    return AwaitableGetAssetSummaryResult(
+        services=pulumi.get(__ret__, 'services'),
+        tags=pulumi.get(__ret__, 'tags'),
+        total=pulumi.get(__ret__, 'total'),
+        types=pulumi.get(__ret__, 'types'))
+def get_asset_summary_output(min_service_counts: Optional[pulumi.Input[Optional[Mapping[str, int]]]] = None,
+                             min_tag_counts: Optional[pulumi.Input[Optional[Mapping[str, int]]]] = None,
+                             min_type_counts: Optional[pulumi.Input[Optional[Mapping[str, int]]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetAssetSummaryResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['minServiceCounts'] = min_service_counts
+    __args__['minTagCounts'] = min_tag_counts
+    __args__['minTypeCounts'] = min_type_counts
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getAssetSummary', __args__, opts=opts, typ=GetAssetSummaryResult)
+    return __ret__.apply(lambda __response__: GetAssetSummaryResult(
+        services=pulumi.get(__response__, 'services'),
+        tags=pulumi.get(__response__, 'tags'),
+        total=pulumi.get(__response__, 'total'),
+        types=pulumi.get(__response__, 'types')))
@@ -0,0 +1,64 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetAuthConfigResult',
+    'AwaitableGetAuthConfigResult',
+    'get_auth_config',
+    'get_auth_config_output',
+]
+
+@pulumi.output_type
+class GetAuthConfigResult:
+    def __init__(__self__, enabled_providers=None):
+        if enabled_providers and not isinstance(enabled_providers, list):
+            raise TypeError("Expected argument 'enabled_providers' to be a list")
+        pulumi.set(__self__, "enabled_providers", enabled_providers)
+
+    @property
+    @pulumi.getter(name="enabledProviders")
+    def enabled_providers(self) -> Sequence[str]:
+        return pulumi.get(self, "enabled_providers")
+
+
+class AwaitableGetAuthConfigResult(GetAuthConfigResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetAuthConfigResult(
+            enabled_providers=self.enabled_providers)
+
+
+def get_auth_config(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetAuthConfigResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getAuthConfig', __args__, opts=opts, typ=GetAuthConfigResult).value
+
+    return AwaitableGetAuthConfigResult(
+        enabled_providers=pulumi.get(__ret__, 'enabled_providers'))
+def get_auth_config_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetAuthConfigResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getAuthConfig', __args__, opts=opts, typ=GetAuthConfigResult)
+    return __ret__.apply(lambda __response__: GetAuthConfigResult(
+        enabled_providers=pulumi.get(__response__, 'enabled_providers')))
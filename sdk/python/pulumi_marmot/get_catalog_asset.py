@@ -0,0 +1,249 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetCatalogAssetResult',
+    'AwaitableGetCatalogAssetResult',
+    'get_catalog_asset',
+    'get_catalog_asset_output',
+]
+
+@pulumi.output_type
+class GetCatalogAssetResult:
+    def __init__(__self__, created_at=None, created_by=None, description=None, environments=None, external_links=None, last_sync_at=None, metadata=None, mrn=None, name=None, parent_mrn=None, resource_id=None, schema=None, services=None, sources=None, tags=None, type=None, updated_at=None):
+        if created_at and not isinstance(created_at, str):
+            raise TypeError("Expected argument 'created_at' to be a str")
+        pulumi.set(__self__, "created_at", created_at)
+        if created_by and not isinstance(created_by, str):
+            raise TypeError("Expected argument 'created_by' to be a str")
+        pulumi.set(__self__, "created_by", created_by)
+        if description and not isinstance(description, str):
+            raise TypeError("Expected argument 'description' to be a str")
+        pulumi.set(__self__, "description", description)
+        if environments and not isinstance(environments, dict):
+            raise TypeError("Expected argument 'environments' to be a dict")
+        pulumi.set(__self__, "environments", environments)
+        if external_links and not isinstance(external_links, list):
+            raise TypeError("Expected argument 'external_links' to be a list")
+        pulumi.set(__self__, "external_links", external_links)
+        if last_sync_at and not isinstance(last_sync_at, str):
+            raise TypeError("Expected argument 'last_sync_at' to be a str")
+        pulumi.set(__self__, "last_sync_at", last_sync_at)
+        if metadata and not isinstance(metadata, dict):
+            raise TypeError("Expected argument 'metadata' to be a dict")
+        pulumi.set(__self__, "metadata", metadata)
+        if mrn and not isinstance(mrn, str):
+            raise TypeError("Expected argument 'mrn' to be a str")
+        pulumi.set(__self__, "mrn", mrn)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if parent_mrn and not isinstance(parent_mrn, str):
+            raise TypeError("Expected argument 'parent_mrn' to be a str")
+        pulumi.set(__self__, "parent_mrn", parent_mrn)
+        if resource_id and not isinstance(resource_id, str):
+            raise TypeError("Expected argument 'resource_id' to be a str")
+        pulumi.set(__self__, "resource_id", resource_id)
+        if schema and not isinstance(schema, dict):
+            raise TypeError("Expected argument 'schema' to be a dict")
+        pulumi.set(__self__, "schema", schema)
+        if services and not isinstance(services, list):
+            raise TypeError("Expected argument 'services' to be a list")
+        pulumi.set(__self__, "services", services)
+        if sources and not isinstance(sources, list):
+            raise TypeError("Expected argument 'sources' to be a list")
+        pulumi.set(__self__, "sources", sources)
+        if tags and not isinstance(tags, list):
+            raise TypeError("Expected argument 'tags' to be a list")
+        pulumi.set(__self__, "tags", tags)
+        if type and not isinstance(type, str):
+            raise TypeError("Expected argument 'type' to be a str")
+        pulumi.set(__self__, "type", type)
+        if updated_at and not isinstance(updated_at, str):
+            raise TypeError("Expected argument 'updated_at' to be a str")
+        pulumi.set(__self__, "updated_at", updated_at)
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> str:
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="createdBy")
+    def created_by(self) -> str:
+        return pulumi.get(self, "created_by")
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[str]:
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def environments(self) -> Optional[Mapping[str, 'outputs.AssetEnvironment']]:
+        return pulumi.get(self, "environments")
+
+    @property
+    @pulumi.getter(name="externalLinks")
+    def external_links(self) -> Optional[Sequence['outputs.ExternalLink']]:
+        return pulumi.get(self, "external_links")
+
+    @property
+    @pulumi.getter(name="lastSyncAt")
+    def last_sync_at(self) -> Optional[str]:
+        return pulumi.get(self, "last_sync_at")
+
+    @property
+    @pulumi.getter
+    def metadata(self) -> Optional[Mapping[str, Any]]:
+        return pulumi.get(self, "metadata")
+
+    @property
+    @pulumi.getter
+    def mrn(self) -> str:
+        return pulumi.get(self, "mrn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="parentMrn")
+    def parent_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "parent_mrn")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def schema(self) -> Optional[Mapping[str, Any]]:
+        return pulumi.get(self, "schema")
+
+    @property
+    @pulumi.getter
+    def services(self) -> Sequence[str]:
+        return pulumi.get(self, "services")
+
+    @property
+    @pulumi.getter
+    def sources(self) -> Optional[Sequence['outputs.AssetSource']]:
+        return pulumi.get(self, "sources")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence[str]]:
+        return pulumi.get(self, "tags")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> str:
+        return pulumi.get(self, "updated_at")
+
+
+class AwaitableGetCatalogAssetResult(GetCatalogAssetResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetCatalogAssetResult(
+            created_at=self.created_at,
+            created_by=self.created_by,
+            description=self.description,
+            environments=self.environments,
+            external_links=self.external_links,
+            last_sync_at=self.last_sync_at,
+            metadata=self.metadata,
+            mrn=self.mrn,
+            name=self.name,
+            parent_mrn=self.parent_mrn,
+            resource_id=self.resource_id,
+            schema=self.schema,
+            services=self.services,
+            sources=self.sources,
+            tags=self.tags,
+            type=self.type,
+            updated_at=self.updated_at)
+
+
+def get_catalog_asset(mrn: Optional[str] = None,
+                      resource_id: Optional[str] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetCatalogAssetResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['mrn'] = mrn
+    __args__['resourceId'] = resource_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getCatalogAsset', __args__, opts=opts, typ=GetCatalogAssetResult).value
+
+    return AwaitableGetCatalogAssetResult(
+        created_at=pulumi.get(__ret__, 'created_at'),
+        created_by=pulumi.get(__ret__, 'created_by'),
+        description=pulumi.get(__ret__, 'description'),
+        environments=pulumi.get(__ret__, 'environments'),
+        external_links=pulumi.get(__ret__, 'external_links'),
+        last_sync_at=pulumi.get(__ret__, 'last_sync_at'),
+        metadata=pulumi.get(__ret__, 'metadata'),
+        mrn=pulumi.get(__ret__, 'mrn'),
+        name=pulumi.get(__ret__, 'name'),
+        parent_mrn=pulumi.get(__ret__, 'parent_mrn'),
+        resource_id=pulumi.get(__ret__, 'resource_id'),
+        schema=pulumi.get(__ret__, 'schema'),
+        services=pulumi.get(__ret__, 'services'),
+        sources=pulumi.get(__ret__, 'sources'),
+        tags=pulumi.get(__ret__, 'tags'),
+        type=pulumi.get(__ret__, 'type'),
+        updated_at=pulumi.get(__ret__, 'updated_at'))
+def get_catalog_asset_output(mrn: Optional[pulumi.Input[Optional[str]]] = None,
+                             resource_id: Optional[pulumi.Input[Optional[str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetCatalogAssetResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['mrn'] = mrn
+    __args__['resourceId'] = resource_id
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getCatalogAsset', __args__, opts=opts, typ=GetCatalogAssetResult)
+    return __ret__.apply(lambda __response__: GetCatalogAssetResult(
+        created_at=pulumi.get(__response__, 'created_at'),
+        created_by=pulumi.get(__response__, 'created_by'),
+        description=pulumi.get(__response__, 'description'),
+        environments=pulumi.get(__response__, 'environments'),
+        external_links=pulumi.get(__response__, 'external_links'),
+        last_sync_at=pulumi.get(__response__, 'last_sync_at'),
+        metadata=pulumi.get(__response__, 'metadata'),
+        mrn=pulumi.get(__response__, 'mrn'),
+        name=pulumi.get(__response__, 'name'),
+        parent_mrn=pulumi.get(__response__, 'parent_mrn'),
+        resource_id=pulumi.get(__response__, 'resource_id'),
+        schema=pulumi.get(__response__, 'schema'),
+        services=pulumi.get(__response__, 'services'),
+        sources=pulumi.get(__response__, 'sources'),
+        tags=pulumi.get(__response__, 'tags'),
+        type=pulumi.get(__response__, 'type'),
+        updated_at=pulumi.get(__response__, 'updated_at')))
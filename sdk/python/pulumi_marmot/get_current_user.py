@@ -0,0 +1,131 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetCurrentUserResult',
+    'AwaitableGetCurrentUserResult',
+    'get_current_user',
+    'get_current_user_output',
+]
+
+@pulumi.output_type
+class GetCurrentUserResult:
+    def __init__(__self__, active=None, created_at=None, name=None, resource_id=None, roles=None, updated_at=None, username=None):
+        if active and not isinstance(active, bool):
+            raise TypeError("Expected argument 'active' to be a bool")
+        pulumi.set(__self__, "active", active)
+        if created_at and not isinstance(created_at, str):
+            raise TypeError("Expected argument 'created_at' to be a str")
+        pulumi.set(__self__, "created_at", created_at)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if resource_id and not isinstance(resource_id, str):
+            raise TypeError("Expected argument 'resource_id' to be a str")
+        pulumi.set(__self__, "resource_id", resource_id)
+        if roles and not isinstance(roles, list):
+            raise TypeError("Expected argument 'roles' to be a list")
+        pulumi.set(__self__, "roles", roles)
+        if updated_at and not isinstance(updated_at, str):
+            raise TypeError("Expected argument 'updated_at' to be a str")
+        pulumi.set(__self__, "updated_at", updated_at)
+        if username and not isinstance(username, str):
+            raise TypeError("Expected argument 'username' to be a str")
+        pulumi.set(__self__, "username", username)
+
+    @property
+    @pulumi.getter
+    def active(self) -> bool:
+        return pulumi.get(self, "active")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> Optional[str]:
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def roles(self) -> Sequence['outputs.UserRole']:
+        return pulumi.get(self, "roles")
+
+    @property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> Optional[str]:
+        return pulumi.get(self, "updated_at")
+
+    @property
+    @pulumi.getter
+    def username(self) -> str:
+        return pulumi.get(self, "username")
+
+
+class AwaitableGetCurrentUserResult(GetCurrentUserResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetCurrentUserResult(
+            active=self.active,
+            created_at=self.created_at,
+            name=self.name,
+            resource_id=self.resource_id,
+            roles=self.roles,
+            updated_at=self.updated_at,
+            username=self.username)
+
+
+def get_current_user(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetCurrentUserResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getCurrentUser', __args__, opts=opts, typ=GetCurrentUserResult).value
+
+    return AwaitableGetCurrentUserResult(
+        active=pulumi.get(__ret__, 'active'),
+        created_at=pulumi.get(__ret__, 'created_at'),
+        name=pulumi.get(__ret__, 'name'),
+        resource_id=pulumi.get(__ret__, 'resource_id'),
+        roles=pulumi.get(__ret__, 'roles'),
+        updated_at=pulumi.get(__ret__, 'updated_at'),
+        username=pulumi.get(__ret__, 'username'))
+def get_current_user_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetCurrentUserResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getCurrentUser', __args__, opts=opts, typ=GetCurrentUserResult)
+    return __ret__.apply(lambda __response__: GetCurrentUserResult(
+        active=pulumi.get(__response__, 'active'),
+        created_at=pulumi.get(__response__, 'created_at'),
+        name=pulumi.get(__response__, 'name'),
+        resource_id=pulumi.get(__response__, 'resource_id'),
+        roles=pulumi.get(__response__, 'roles'),
+        updated_at=pulumi.get(__response__, 'updated_at'),
+        username=pulumi.get(__response__, 'username')))
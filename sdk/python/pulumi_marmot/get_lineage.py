@@ -0,0 +1,88 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetLineageResult',
+    'AwaitableGetLineageResult',
+    'get_lineage',
+    'get_lineage_output',
+]
+
+@pulumi.output_type
+class GetLineageResult:
+    def __init__(__self__, edges=None, nodes=None):
+        if edges and not isinstance(edges, list):
+            raise TypeError("Expected argument 'edges' to be a list")
+        pulumi.set(__self__, "edges", edges)
+        if nodes and not isinstance(nodes, list):
+            raise TypeError("Expected argument 'nodes' to be a list")
+        pulumi.set(__self__, "nodes", nodes)
+
+    @property
+    @pulumi.getter
+    def edges(self) -> Sequence['outputs.LineageEdge']:
+        return pulumi.get(self, "edges")
+
+    @property
+    @pulumi.getter
+    def nodes(self) -> Sequence['outputs.LineageNode']:
+        return pulumi.get(self, "nodes")
+
+
+class AwaitableGetLineageResult(GetLineageResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetLineageResult(
+            edges=self.edges,
+            nodes=self.nodes)
+
+
+def get_lineage(depth: Optional[int] = None,
+                direction: Optional[str] = None,
+                resource_id: Optional[str] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetLineageResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['depth'] = depth
+    __args__['direction'] = direction
+    __args__['resourceId'] = resource_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getLineage', __args__, opts=opts, typ=GetLineageResult).value
+
+    return AwaitableGetLineageResult(
+        edges=pulumi.get(__ret__, 'edges'),
+        nodes=pulumi.get(__ret__, 'nodes'))
+def get_lineage_output(depth: Optional[pulumi.Input[Optional[int]]] = None,
+                       direction: Optional[pulumi.Input[Optional[str]]] = None,
+                       resource_id: Optional[pulumi.Input[str]] = None,
+                       opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetLineageResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['depth'] = depth
+    __args__['direction'] = direction
+    __args__['resourceId'] = resource_id
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getLineage', __args__, opts=opts, typ=GetLineageResult)
+    return __ret__.apply(lambda __response__: GetLineageResult(
+        edges=pulumi.get(__response__, 'edges'),
+        nodes=pulumi.get(__response__, 'nodes')))
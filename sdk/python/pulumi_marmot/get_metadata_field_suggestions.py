@@ -0,0 +1,73 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetMetadataFieldSuggestionsResult',
+    'AwaitableGetMetadataFieldSuggestionsResult',
+    'get_metadata_field_suggestions',
+    'get_metadata_field_suggestions_output',
+]
+
+@pulumi.output_type
+class GetMetadataFieldSuggestionsResult:
+    def __init__(__self__, fields=None):
+        if fields and not isinstance(fields, list):
+            raise TypeError("Expected argument 'fields' to be a list")
+        pulumi.set(__self__, "fields", fields)
+
+    @property
+    @pulumi.getter
+    def fields(self) -> Sequence['outputs.MetadataFieldSuggestion']:
+        return pulumi.get(self, "fields")
+
+
+class AwaitableGetMetadataFieldSuggestionsResult(GetMetadataFieldSuggestionsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetMetadataFieldSuggestionsResult(
+            fields=self.fields)
+
+
+def get_metadata_field_suggestions(limit: Optional[int] = None,
+                                   prefix: Optional[str] = None,
+                                   opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetMetadataFieldSuggestionsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['limit'] = limit
+    __args__['prefix'] = prefix
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getMetadataFieldSuggestions', __args__, opts=opts, typ=GetMetadataFieldSuggestionsResult).value
+
+    return AwaitableGetMetadataFieldSuggestionsResult(
+        fields=pulumi.get(__ret__, 'fields'))
+def get_metadata_field_suggestions_output(limit: Optional[pulumi.Input[Optional[int]]] = None,
+                                          prefix: Optional[pulumi.Input[Optional[str]]] = None,
+                                          opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetMetadataFieldSuggestionsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['limit'] = limit
+    __args__['prefix'] = prefix
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getMetadataFieldSuggestions', __args__, opts=opts, typ=GetMetadataFieldSuggestionsResult)
+    return __ret__.apply(lambda __response__: GetMetadataFieldSuggestionsResult(
+        fields=pulumi.get(__response__, 'fields')))
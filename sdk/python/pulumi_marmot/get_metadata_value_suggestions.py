@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetMetadataValueSuggestionsResult',
+    'AwaitableGetMetadataValueSuggestionsResult',
+    'get_metadata_value_suggestions',
+    'get_metadata_value_suggestions_output',
+]
+
+@pulumi.output_type
+class GetMetadataValueSuggestionsResult:
+    def __init__(__self__, values=None):
+        if values and not isinstance(values, list):
+            raise TypeError("Expected argument 'values' to be a list")
+        pulumi.set(__self__, "values", values)
+
+    @property
+    @pulumi.getter
+    def values(self) -> Sequence['outputs.MetadataValueSuggestion']:
+        return pulumi.get(self, "values")
+
+
+class AwaitableGetMetadataValueSuggestionsResult(GetMetadataValueSuggestionsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetMetadataValueSuggestionsResult(
+            values=self.values)
+
+
+def get_metadata_value_suggestions(field: Optional[str] = None,
+                                   limit: Optional[int] = None,
+                                   prefix: Optional[str] = None,
+                                   opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetMetadataValueSuggestionsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['field'] = field
+    __args__['limit'] = limit
+    __args__['prefix'] = prefix
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getMetadataValueSuggestions', __args__, opts=opts, typ=GetMetadataValueSuggestionsResult).value
+
+    return AwaitableGetMetadataValueSuggestionsResult(
+        values=pulumi.get(__ret__, 'values'))
+def get_metadata_value_suggestions_output(field: Optional[pulumi.Input[str]] = None,
+                                          limit: Optional[pulumi.Input[Optional[int]]] = None,
+                                          prefix: Optional[pulumi.Input[Optional[str]]] = None,
+                                          opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetMetadataValueSuggestionsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['field'] = field
+    __args__['limit'] = limit
+    __args__['prefix'] = prefix
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getMetadataValueSuggestions', __args__, opts=opts, typ=GetMetadataValueSuggestionsResult)
+    return __ret__.apply(lambda __response__: GetMetadataValueSuggestionsResult(
+        values=pulumi.get(__response__, 'values')))
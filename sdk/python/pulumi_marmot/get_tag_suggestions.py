@@ -0,0 +1,72 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'GetTagSuggestionsResult',
+    'AwaitableGetTagSuggestionsResult',
+    'get_tag_suggestions',
+    'get_tag_suggestions_output',
+]
+
+@pulumi.output_type
+class GetTagSuggestionsResult:
+    def __init__(__self__, tags=None):
+        if tags and not isinstance(tags, list):
+            raise TypeError("Expected argument 'tags' to be a list")
+        pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Sequence[str]:
+        return pulumi.get(self, "tags")
+
+
+class AwaitableGetTagSuggestionsResult(GetTagSuggestionsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetTagSuggestionsResult(
+            tags=self.tags)
+
+
+def get_tag_suggestions(limit: Optional[int] = None,
+                        prefix: Optional[str] = None,
+                        opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetTagSuggestionsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['limit'] = limit
+    __args__['prefix'] = prefix
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:getTagSuggestions', __args__, opts=opts, typ=GetTagSuggestionsResult).value
+
+    return AwaitableGetTagSuggestionsResult(
+        tags=pulumi.get(__ret__, 'tags'))
+def get_tag_suggestions_output(limit: Optional[pulumi.Input[Optional[int]]] = None,
+                               prefix: Optional[pulumi.Input[Optional[str]]] = None,
+                               opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetTagSuggestionsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['limit'] = limit
+    __args__['prefix'] = prefix
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:getTagSuggestions', __args__, opts=opts, typ=GetTagSuggestionsResult)
+    return __ret__.apply(lambda __response__: GetTagSuggestionsResult(
+        tags=pulumi.get(__response__, 'tags')))
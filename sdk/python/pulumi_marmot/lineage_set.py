@@ -0,0 +1,124 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['LineageSetArgs', 'LineageSet']
+
+@pulumi.input_type
+class LineageSetArgs:
+    def __init__(__self__, *,
+                 edges: pulumi.Input[Sequence[pulumi.Input['LineageSetEdgeArgs']]]):
+        """
+        The set of arguments for constructing a LineageSet resource.
+        """
+        pulumi.set(__self__, "edges", edges)
+
+    @property
+    @pulumi.getter
+    def edges(self) -> pulumi.Input[Sequence[pulumi.Input['LineageSetEdgeArgs']]]:
+        return pulumi.get(self, "edges")
+
+    @edges.setter
+    def edges(self, value: pulumi.Input[Sequence[pulumi.Input['LineageSetEdgeArgs']]]):
+        pulumi.set(self, "edges", value)
+
+
+class LineageSet(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 edges: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['LineageSetEdgeArgs']]]]] = None,
+                 __props__=None):
+        """
+        Create a LineageSet resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: LineageSetArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a LineageSet resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param LineageSetArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(LineageSetArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 edges: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['LineageSetEdgeArgs']]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = LineageSetArgs.__new__(LineageSetArgs)
+
+            if edges is None and not opts.urn:
+                raise TypeError("Missing required property 'edges'")
+            __props__.__dict__["edges"] = edges
+            __props__.__dict__["results"] = None
+        super(LineageSet, __self__).__init__(
+            'marmot:index:LineageSet',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'LineageSet':
+        """
+        Get an existing LineageSet resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = LineageSetArgs.__new__(LineageSetArgs)
+
+        __props__.__dict__["edges"] = None
+        __props__.__dict__["results"] = None
+        return LineageSet(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def edges(self) -> pulumi.Output[Sequence['outputs.LineageSetEdge']]:
+        return pulumi.get(self, "edges")
+
+    @property
+    @pulumi.getter
+    def results(self) -> pulumi.Output[Sequence['outputs.LineageSetEdgeResult']]:
+        return pulumi.get(self, "results")
+
@@ -0,0 +1,95 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ListAssetsResult',
+    'AwaitableListAssetsResult',
+    'list_assets',
+    'list_assets_output',
+]
+
+@pulumi.output_type
+class ListAssetsResult:
+    def __init__(__self__, assets=None, count=None, total=None):
+        if assets and not isinstance(assets, list):
+            raise TypeError("Expected argument 'assets' to be a list")
+        pulumi.set(__self__, "assets", assets)
+        if count and not isinstance(count, int):
+            raise TypeError("Expected argument 'count' to be a int")
+        pulumi.set(__self__, "count", count)
+        if total and not isinstance(total, int):
+            raise TypeError("Expected argument 'total' to be a int")
+        pulumi.set(__self__, "total", total)
+
+    @property
+    @pulumi.getter
+    def assets(self) -> Sequence['outputs.AssetSummary']:
+        return pulumi.get(self, "assets")
+
+    @property
+    @pulumi.getter
+    def count(self) -> int:
+        return pulumi.get(self, "count")
+
+    @property
+    @pulumi.getter
+    def total(self) -> int:
+        return pulumi.get(self, "total")
+
+
+class AwaitableListAssetsResult(ListAssetsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ListAssetsResult(
+            assets=self.assets,
+            count=self.count,
+            total=self.total)
+
+
+def list_assets(page_size: Optional[int] = None,
+                parallelism: Optional[int] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableListAssetsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['pageSize'] = page_size
+    __args__['parallelism'] = parallelism
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:listAssets', __args__, opts=opts, typ=ListAssetsResult).value
+
+    return AwaitableListAssetsResult(
+        assets=pulumi.get(__ret__, 'assets'),
+        count=pulumi.get(__ret__, 'count'),
+        total=pulumi.get(__ret__, 'total'))
+def list_assets_output(page_size: Optional[pulumi.Input[Optional[int]]] = None,
+                       parallelism: Optional[pulumi.Input[Optional[int]]] = None,
+                       opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ListAssetsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['pageSize'] = page_size
+    __args__['parallelism'] = parallelism
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:listAssets', __args__, opts=opts, typ=ListAssetsResult)
+    return __ret__.apply(lambda __response__: ListAssetsResult(
+        assets=pulumi.get(__response__, 'assets'),
+        count=pulumi.get(__response__, 'count'),
+        total=pulumi.get(__response__, 'total')))
@@ -0,0 +1,73 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ListManagedAssetsResult',
+    'AwaitableListManagedAssetsResult',
+    'list_managed_assets',
+    'list_managed_assets_output',
+]
+
+@pulumi.output_type
+class ListManagedAssetsResult:
+    def __init__(__self__, assets=None):
+        if assets and not isinstance(assets, list):
+            raise TypeError("Expected argument 'assets' to be a list")
+        pulumi.set(__self__, "assets", assets)
+
+    @property
+    @pulumi.getter
+    def assets(self) -> Sequence['outputs.ManagedAsset']:
+        return pulumi.get(self, "assets")
+
+
+class AwaitableListManagedAssetsResult(ListManagedAssetsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ListManagedAssetsResult(
+            assets=self.assets)
+
+
+def list_managed_assets(project: Optional[str] = None,
+                        stack: Optional[str] = None,
+                        opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableListManagedAssetsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['project'] = project
+    __args__['stack'] = stack
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:listManagedAssets', __args__, opts=opts, typ=ListManagedAssetsResult).value
+
+    return AwaitableListManagedAssetsResult(
+        assets=pulumi.get(__ret__, 'assets'))
+def list_managed_assets_output(project: Optional[pulumi.Input[Optional[str]]] = None,
+                               stack: Optional[pulumi.Input[str]] = None,
+                               opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ListManagedAssetsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['project'] = project
+    __args__['stack'] = stack
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:listManagedAssets', __args__, opts=opts, typ=ListManagedAssetsResult)
+    return __ret__.apply(lambda __response__: ListManagedAssetsResult(
+        assets=pulumi.get(__response__, 'assets')))
@@ -0,0 +1,100 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ListUsersResult',
+    'AwaitableListUsersResult',
+    'list_users',
+    'list_users_output',
+]
+
+@pulumi.output_type
+class ListUsersResult:
+    def __init__(__self__, total=None, users=None):
+        if total and not isinstance(total, int):
+            raise TypeError("Expected argument 'total' to be a int")
+        pulumi.set(__self__, "total", total)
+        if users and not isinstance(users, list):
+            raise TypeError("Expected argument 'users' to be a list")
+        pulumi.set(__self__, "users", users)
+
+    @property
+    @pulumi.getter
+    def total(self) -> int:
+        return pulumi.get(self, "total")
+
+    @property
+    @pulumi.getter
+    def users(self) -> Sequence['outputs.User']:
+        return pulumi.get(self, "users")
+
+
+class AwaitableListUsersResult(ListUsersResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ListUsersResult(
+            total=self.total,
+            users=self.users)
+
+
+def list_users(active: Optional[bool] = None,
+               limit: Optional[int] = None,
+               max_results: Optional[int] = None,
+               offset: Optional[int] = None,
+               query: Optional[str] = None,
+               role_ids: Optional[Sequence[str]] = None,
+               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableListUsersResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['active'] = active
+    __args__['limit'] = limit
+    __args__['maxResults'] = max_results
+    __args__['offset'] = offset
+    __args__['query'] = query
+    __args__['roleIds'] = role_ids
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:listUsers', __args__, opts=opts, typ=ListUsersResult).value
+
+    return AwaitableListUsersResult(
+        total=pulumi.get(__ret__, 'total'),
+        users=pulumi.get(__ret__, 'users'))
+def list_users_output(active: Optional[pulumi.Input[Optional[bool]]] = None,
+                      limit: Optional[pulumi.Input[Optional[int]]] = None,
+                      max_results: Optional[pulumi.Input[Optional[int]]] = None,
+                      offset: Optional[pulumi.Input[Optional[int]]] = None,
+                      query: Optional[pulumi.Input[Optional[str]]] = None,
+                      role_ids: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                      opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ListUsersResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['active'] = active
+    __args__['limit'] = limit
+    __args__['maxResults'] = max_results
+    __args__['offset'] = offset
+    __args__['query'] = query
+    __args__['roleIds'] = role_ids
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:listUsers', __args__, opts=opts, typ=ListUsersResult)
+    return __ret__.apply(lambda __response__: ListUsersResult(
+        total=pulumi.get(__response__, 'total'),
+        users=pulumi.get(__response__, 'users')))
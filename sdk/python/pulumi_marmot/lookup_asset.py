@@ -0,0 +1,77 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'LookupAssetResult',
+    'AwaitableLookupAssetResult',
+    'lookup_asset',
+    'lookup_asset_output',
+]
+
+@pulumi.output_type
+class LookupAssetResult:
+    def __init__(__self__, asset=None):
+        if asset and not isinstance(asset, dict):
+            raise TypeError("Expected argument 'asset' to be a dict")
+        pulumi.set(__self__, "asset", asset)
+
+    @property
+    @pulumi.getter
+    def asset(self) -> Optional['outputs.CatalogAsset']:
+        return pulumi.get(self, "asset")
+
+
+class AwaitableLookupAssetResult(LookupAssetResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return LookupAssetResult(
+            asset=self.asset)
+
+
+def lookup_asset(allow_missing: Optional[bool] = None,
+                 name: Optional[str] = None,
+                 type: Optional[str] = None,
+                 opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableLookupAssetResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['allowMissing'] = allow_missing
+    __args__['name'] = name
+    __args__['type'] = type
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:lookupAsset', __args__, opts=opts, typ=LookupAssetResult).value
+
+    return AwaitableLookupAssetResult(
+        asset=pulumi.get(__ret__, 'asset'))
+def lookup_asset_output(allow_missing: Optional[pulumi.Input[Optional[bool]]] = None,
+                        name: Optional[pulumi.Input[str]] = None,
+                        type: Optional[pulumi.Input[str]] = None,
+                        opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[LookupAssetResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['allowMissing'] = allow_missing
+    __args__['name'] = name
+    __args__['type'] = type
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:lookupAsset', __args__, opts=opts, typ=LookupAssetResult)
+    return __ret__.apply(lambda __response__: LookupAssetResult(
+        asset=pulumi.get(__response__, 'asset')))
@@ -0,0 +1,84 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'MatchAssetsResult',
+    'AwaitableMatchAssetsResult',
+    'match_assets',
+    'match_assets_output',
+]
+
+@pulumi.output_type
+class MatchAssetsResult:
+    def __init__(__self__, matches=None, mrns=None):
+        if matches and not isinstance(matches, list):
+            raise TypeError("Expected argument 'matches' to be a list")
+        pulumi.set(__self__, "matches", matches)
+        if mrns and not isinstance(mrns, list):
+            raise TypeError("Expected argument 'mrns' to be a list")
+        pulumi.set(__self__, "mrns", mrns)
+
+    @property
+    @pulumi.getter
+    def matches(self) -> Sequence['outputs.AssetMatch']:
+        return pulumi.get(self, "matches")
+
+    @property
+    @pulumi.getter
+    def mrns(self) -> Sequence[str]:
+        return pulumi.get(self, "mrns")
+
+
+class AwaitableMatchAssetsResult(MatchAssetsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return MatchAssetsResult(
+            matches=self.matches,
+            mrns=self.mrns)
+
+
+def match_assets(pattern: Optional[str] = None,
+                 type: Optional[str] = None,
+                 opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableMatchAssetsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['pattern'] = pattern
+    __args__['type'] = type
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:matchAssets', __args__, opts=opts, typ=MatchAssetsResult).value
+
+    return AwaitableMatchAssetsResult(
+        matches=pulumi.get(__ret__, 'matches'),
+        mrns=pulumi.get(__ret__, 'mrns'))
+def match_assets_output(pattern: Optional[pulumi.Input[str]] = None,
+                        type: Optional[pulumi.Input[str]] = None,
+                        opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[MatchAssetsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['pattern'] = pattern
+    __args__['type'] = type
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:matchAssets', __args__, opts=opts, typ=MatchAssetsResult)
+    return __ret__.apply(lambda __response__: MatchAssetsResult(
+        matches=pulumi.get(__response__, 'matches'),
+        mrns=pulumi.get(__response__, 'mrns')))
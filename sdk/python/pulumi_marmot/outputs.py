@@ -13,13 +13,139 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from . import _utilities
+from . import outputs
 
 __all__ = [
+    'AssetCollectionItem',
     'AssetEnvironment',
+    'AssetFilters',
+    'AssetMatch',
     'AssetSource',
+    'AssetSummary',
+    'CatalogAsset',
+    'DocumentResult',
     'ExternalLink',
+    'LineageEdge',
+    'LineageNode',
+    'LineageSetEdge',
+    'LineageSetEdgeResult',
+    'ManagedAsset',
+    'MetadataFieldSuggestion',
+    'MetadataValueSuggestion',
+    'UpstreamEdge',
+    'User',
+    'UserRole',
 ]
 
+@pulumi.output_type
+class AssetCollectionItem(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "externalLinks":
+            suggest = "external_links"
+        elif key == "ignoreMetadataKeys":
+            suggest = "ignore_metadata_keys"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in AssetCollectionItem. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        AssetCollectionItem.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        AssetCollectionItem.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 name: str,
+                 services: Sequence[str],
+                 type: str,
+                 description: Optional[str] = None,
+                 environments: Optional[Mapping[str, 'outputs.AssetEnvironment']] = None,
+                 external_links: Optional[Sequence['outputs.ExternalLink']] = None,
+                 ignore_metadata_keys: Optional[Sequence[str]] = None,
+                 metadata: Optional[Mapping[str, Any]] = None,
+                 schema: Optional[Mapping[str, Any]] = None,
+                 sources: Optional[Sequence['outputs.AssetSource']] = None,
+                 tags: Optional[Sequence[str]] = None):
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "services", services)
+        pulumi.set(__self__, "type", type)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if environments is not None:
+            pulumi.set(__self__, "environments", environments)
+        if external_links is not None:
+            pulumi.set(__self__, "external_links", external_links)
+        if ignore_metadata_keys is not None:
+            pulumi.set(__self__, "ignore_metadata_keys", ignore_metadata_keys)
+        if metadata is not None:
+            pulumi.set(__self__, "metadata", metadata)
+        if schema is not None:
+            pulumi.set(__self__, "schema", schema)
+        if sources is not None:
+            pulumi.set(__self__, "sources", sources)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def services(self) -> Sequence[str]:
+        return pulumi.get(self, "services")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[str]:
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def environments(self) -> Optional[Mapping[str, 'outputs.AssetEnvironment']]:
+        return pulumi.get(self, "environments")
+
+    @property
+    @pulumi.getter(name="externalLinks")
+    def external_links(self) -> Optional[Sequence['outputs.ExternalLink']]:
+        return pulumi.get(self, "external_links")
+
+    @property
+    @pulumi.getter(name="ignoreMetadataKeys")
+    def ignore_metadata_keys(self) -> Optional[Sequence[str]]:
+        return pulumi.get(self, "ignore_metadata_keys")
+
+    @property
+    @pulumi.getter
+    def metadata(self) -> Optional[Mapping[str, Any]]:
+        return pulumi.get(self, "metadata")
+
+    @property
+    @pulumi.getter
+    def schema(self) -> Optional[Mapping[str, Any]]:
+        return pulumi.get(self, "schema")
+
+    @property
+    @pulumi.getter
+    def sources(self) -> Optional[Sequence['outputs.AssetSource']]:
+        return pulumi.get(self, "sources")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence[str]]:
+        return pulumi.get(self, "tags")
+
+
 @pulumi.output_type
 class AssetEnvironment(dict):
     def __init__(__self__, *,
@@ -47,6 +173,58 @@ class AssetEnvironment(dict):
         return pulumi.get(self, "metadata")
 
 
+@pulumi.output_type
+class AssetFilters(dict):
+    def __init__(__self__, *,
+                 services: Mapping[str, int],
+                 tags: Mapping[str, int],
+                 types: Mapping[str, int]):
+        pulumi.set(__self__, "services", services)
+        pulumi.set(__self__, "tags", tags)
+        pulumi.set(__self__, "types", types)
+
+    @property
+    @pulumi.getter
+    def services(self) -> Mapping[str, int]:
+        return pulumi.get(self, "services")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Mapping[str, int]:
+        return pulumi.get(self, "tags")
+
+    @property
+    @pulumi.getter
+    def types(self) -> Mapping[str, int]:
+        return pulumi.get(self, "types")
+
+
+@pulumi.output_type
+class AssetMatch(dict):
+    def __init__(__self__, *,
+                 mrn: str,
+                 name: str,
+                 resource_id: str):
+        pulumi.set(__self__, "mrn", mrn)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "resource_id", resource_id)
+
+    @property
+    @pulumi.getter
+    def mrn(self) -> str:
+        return pulumi.get(self, "mrn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+
 @pulumi.output_type
 class AssetSource(dict):
     def __init__(__self__, *,
@@ -75,6 +253,236 @@ class AssetSource(dict):
         return pulumi.get(self, "properties")
 
 
+@pulumi.output_type
+class AssetSummary(dict):
+    def __init__(__self__, *,
+                 mrn: str,
+                 name: str,
+                 resource_id: str,
+                 services: Sequence[str],
+                 type: str,
+                 updated_at: str,
+                 parent_mrn: Optional[str] = None,
+                 tags: Optional[Sequence[str]] = None):
+        pulumi.set(__self__, "mrn", mrn)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "services", services)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "updated_at", updated_at)
+        if parent_mrn is not None:
+            pulumi.set(__self__, "parent_mrn", parent_mrn)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter
+    def mrn(self) -> str:
+        return pulumi.get(self, "mrn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def services(self) -> Sequence[str]:
+        return pulumi.get(self, "services")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> str:
+        return pulumi.get(self, "updated_at")
+
+    @property
+    @pulumi.getter(name="parentMrn")
+    def parent_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "parent_mrn")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence[str]]:
+        return pulumi.get(self, "tags")
+
+
+@pulumi.output_type
+class CatalogAsset(dict):
+    def __init__(__self__, *,
+                 created_at: str,
+                 created_by: str,
+                 mrn: str,
+                 name: str,
+                 resource_id: str,
+                 services: Sequence[str],
+                 type: str,
+                 updated_at: str,
+                 description: Optional[str] = None,
+                 environments: Optional[Mapping[str, 'outputs.AssetEnvironment']] = None,
+                 external_links: Optional[Sequence['outputs.ExternalLink']] = None,
+                 last_sync_at: Optional[str] = None,
+                 metadata: Optional[Mapping[str, Any]] = None,
+                 parent_mrn: Optional[str] = None,
+                 schema: Optional[Mapping[str, Any]] = None,
+                 sources: Optional[Sequence['outputs.AssetSource']] = None,
+                 tags: Optional[Sequence[str]] = None):
+        pulumi.set(__self__, "created_at", created_at)
+        pulumi.set(__self__, "created_by", created_by)
+        pulumi.set(__self__, "mrn", mrn)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "services", services)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "updated_at", updated_at)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if environments is not None:
+            pulumi.set(__self__, "environments", environments)
+        if external_links is not None:
+            pulumi.set(__self__, "external_links", external_links)
+        if last_sync_at is not None:
+            pulumi.set(__self__, "last_sync_at", last_sync_at)
+        if metadata is not None:
+            pulumi.set(__self__, "metadata", metadata)
+        if parent_mrn is not None:
+            pulumi.set(__self__, "parent_mrn", parent_mrn)
+        if schema is not None:
+            pulumi.set(__self__, "schema", schema)
+        if sources is not None:
+            pulumi.set(__self__, "sources", sources)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> str:
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="createdBy")
+    def created_by(self) -> str:
+        return pulumi.get(self, "created_by")
+
+    @property
+    @pulumi.getter
+    def mrn(self) -> str:
+        return pulumi.get(self, "mrn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def services(self) -> Sequence[str]:
+        return pulumi.get(self, "services")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> str:
+        return pulumi.get(self, "updated_at")
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[str]:
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def environments(self) -> Optional[Mapping[str, 'outputs.AssetEnvironment']]:
+        return pulumi.get(self, "environments")
+
+    @property
+    @pulumi.getter(name="externalLinks")
+    def external_links(self) -> Optional[Sequence['outputs.ExternalLink']]:
+        return pulumi.get(self, "external_links")
+
+    @property
+    @pulumi.getter(name="lastSyncAt")
+    def last_sync_at(self) -> Optional[str]:
+        return pulumi.get(self, "last_sync_at")
+
+    @property
+    @pulumi.getter
+    def metadata(self) -> Optional[Mapping[str, Any]]:
+        return pulumi.get(self, "metadata")
+
+    @property
+    @pulumi.getter(name="parentMrn")
+    def parent_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "parent_mrn")
+
+    @property
+    @pulumi.getter
+    def schema(self) -> Optional[Mapping[str, Any]]:
+        return pulumi.get(self, "schema")
+
+    @property
+    @pulumi.getter
+    def sources(self) -> Optional[Sequence['outputs.AssetSource']]:
+        return pulumi.get(self, "sources")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence[str]]:
+        return pulumi.get(self, "tags")
+
+
+@pulumi.output_type
+class DocumentResult(dict):
+    def __init__(__self__, *,
+                 hash: str,
+                 mrn: str,
+                 status: str,
+                 error: Optional[str] = None):
+        pulumi.set(__self__, "hash", hash)
+        pulumi.set(__self__, "mrn", mrn)
+        pulumi.set(__self__, "status", status)
+        if error is not None:
+            pulumi.set(__self__, "error", error)
+
+    @property
+    @pulumi.getter
+    def hash(self) -> str:
+        return pulumi.get(self, "hash")
+
+    @property
+    @pulumi.getter
+    def mrn(self) -> str:
+        return pulumi.get(self, "mrn")
+
+    @property
+    @pulumi.getter
+    def status(self) -> str:
+        return pulumi.get(self, "status")
+
+    @property
+    @pulumi.getter
+    def error(self) -> Optional[str]:
+        return pulumi.get(self, "error")
+
+
 @pulumi.output_type
 class ExternalLink(dict):
     def __init__(__self__, *,
@@ -102,3 +510,450 @@ class ExternalLink(dict):
         return pulumi.get(self, "icon")
 
 
+@pulumi.output_type
+class LineageEdge(dict):
+    def __init__(__self__, *,
+                 resource_id: str,
+                 source: str,
+                 target: str,
+                 job_mrn: Optional[str] = None,
+                 type: Optional[str] = None):
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "source", source)
+        pulumi.set(__self__, "target", target)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def source(self) -> str:
+        return pulumi.get(self, "source")
+
+    @property
+    @pulumi.getter
+    def target(self) -> str:
+        return pulumi.get(self, "target")
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "job_mrn")
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class LineageNode(dict):
+    def __init__(__self__, *,
+                 depth: int,
+                 resource_id: str,
+                 type: str,
+                 asset: Optional['outputs.CatalogAsset'] = None):
+        pulumi.set(__self__, "depth", depth)
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "type", type)
+        if asset is not None:
+            pulumi.set(__self__, "asset", asset)
+
+    @property
+    @pulumi.getter
+    def depth(self) -> int:
+        return pulumi.get(self, "depth")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def asset(self) -> Optional['outputs.CatalogAsset']:
+        return pulumi.get(self, "asset")
+
+
+@pulumi.output_type
+class LineageSetEdge(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "jobMrn":
+            suggest = "job_mrn"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in LineageSetEdge. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        LineageSetEdge.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        LineageSetEdge.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 source: str,
+                 target: str,
+                 job_mrn: Optional[str] = None):
+        pulumi.set(__self__, "source", source)
+        pulumi.set(__self__, "target", target)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+
+    @property
+    @pulumi.getter
+    def source(self) -> str:
+        return pulumi.get(self, "source")
+
+    @property
+    @pulumi.getter
+    def target(self) -> str:
+        return pulumi.get(self, "target")
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "job_mrn")
+
+
+@pulumi.output_type
+class LineageSetEdgeResult(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "resourceId":
+            suggest = "resource_id"
+        elif key == "jobMrn":
+            suggest = "job_mrn"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in LineageSetEdgeResult. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        LineageSetEdgeResult.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        LineageSetEdgeResult.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 resource_id: str,
+                 source: str,
+                 status: str,
+                 target: str,
+                 job_mrn: Optional[str] = None,
+                 type: Optional[str] = None):
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "source", source)
+        pulumi.set(__self__, "status", status)
+        pulumi.set(__self__, "target", target)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def source(self) -> str:
+        return pulumi.get(self, "source")
+
+    @property
+    @pulumi.getter
+    def status(self) -> str:
+        return pulumi.get(self, "status")
+
+    @property
+    @pulumi.getter
+    def target(self) -> str:
+        return pulumi.get(self, "target")
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "job_mrn")
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class ManagedAsset(dict):
+    def __init__(__self__, *,
+                 id: str,
+                 mrn: str,
+                 name: str,
+                 type: str,
+                 urn: str):
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "mrn", mrn)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "urn", urn)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def mrn(self) -> str:
+        return pulumi.get(self, "mrn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def urn(self) -> str:
+        return pulumi.get(self, "urn")
+
+
+@pulumi.output_type
+class MetadataFieldSuggestion(dict):
+    def __init__(__self__, *,
+                 count: int,
+                 field: str,
+                 path_parts: Sequence[str],
+                 type: str,
+                 types: Sequence[str],
+                 example: Optional[Any] = None):
+        pulumi.set(__self__, "count", count)
+        pulumi.set(__self__, "field", field)
+        pulumi.set(__self__, "path_parts", path_parts)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "types", types)
+        if example is not None:
+            pulumi.set(__self__, "example", example)
+
+    @property
+    @pulumi.getter
+    def count(self) -> int:
+        return pulumi.get(self, "count")
+
+    @property
+    @pulumi.getter
+    def field(self) -> str:
+        return pulumi.get(self, "field")
+
+    @property
+    @pulumi.getter(name="pathParts")
+    def path_parts(self) -> Sequence[str]:
+        return pulumi.get(self, "path_parts")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def types(self) -> Sequence[str]:
+        return pulumi.get(self, "types")
+
+    @property
+    @pulumi.getter
+    def example(self) -> Optional[Any]:
+        return pulumi.get(self, "example")
+
+
+@pulumi.output_type
+class MetadataValueSuggestion(dict):
+    def __init__(__self__, *,
+                 count: int,
+                 value: str,
+                 example_mrn: Optional[str] = None):
+        pulumi.set(__self__, "count", count)
+        pulumi.set(__self__, "value", value)
+        if example_mrn is not None:
+            pulumi.set(__self__, "example_mrn", example_mrn)
+
+    @property
+    @pulumi.getter
+    def count(self) -> int:
+        return pulumi.get(self, "count")
+
+    @property
+    @pulumi.getter
+    def value(self) -> str:
+        return pulumi.get(self, "value")
+
+    @property
+    @pulumi.getter(name="exampleMrn")
+    def example_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "example_mrn")
+
+
+@pulumi.output_type
+class UpstreamEdge(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "resourceId":
+            suggest = "resource_id"
+        elif key == "jobMrn":
+            suggest = "job_mrn"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in UpstreamEdge. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        UpstreamEdge.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        UpstreamEdge.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 resource_id: str,
+                 source: str,
+                 created: Optional[bool] = None,
+                 job_mrn: Optional[str] = None):
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "source", source)
+        if created is not None:
+            pulumi.set(__self__, "created", created)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def source(self) -> str:
+        return pulumi.get(self, "source")
+
+    @property
+    @pulumi.getter
+    def created(self) -> Optional[bool]:
+        return pulumi.get(self, "created")
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[str]:
+        return pulumi.get(self, "job_mrn")
+
+
+@pulumi.output_type
+class User(dict):
+    def __init__(__self__, *,
+                 active: bool,
+                 name: str,
+                 resource_id: str,
+                 roles: Sequence['outputs.UserRole'],
+                 username: str,
+                 created_at: Optional[str] = None,
+                 updated_at: Optional[str] = None):
+        pulumi.set(__self__, "active", active)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "roles", roles)
+        pulumi.set(__self__, "username", username)
+        if created_at is not None:
+            pulumi.set(__self__, "created_at", created_at)
+        if updated_at is not None:
+            pulumi.set(__self__, "updated_at", updated_at)
+
+    @property
+    @pulumi.getter
+    def active(self) -> bool:
+        return pulumi.get(self, "active")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter
+    def roles(self) -> Sequence['outputs.UserRole']:
+        return pulumi.get(self, "roles")
+
+    @property
+    @pulumi.getter
+    def username(self) -> str:
+        return pulumi.get(self, "username")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> Optional[str]:
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> Optional[str]:
+        return pulumi.get(self, "updated_at")
+
+
+@pulumi.output_type
+class UserRole(dict):
+    def __init__(__self__, *,
+                 id: str,
+                 name: str,
+                 permissions: Sequence[str],
+                 description: Optional[str] = None):
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "permissions", permissions)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def permissions(self) -> Sequence[str]:
+        return pulumi.get(self, "permissions")
+
+    @property
+    @pulumi.getter
+    def description(self) -> Optional[str]:
+        return pulumi.get(self, "description")
+
+
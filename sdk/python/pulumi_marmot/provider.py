@@ -20,12 +20,42 @@ __all__ = ['ProviderArgs', 'Provider']
 class ProviderArgs:
     def __init__(__self__, *,
                  api_key: pulumi.Input[str],
-                 host: pulumi.Input[str]):
+                 host: pulumi.Input[str],
+                 allowed_tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 batch_creates: Optional[pulumi.Input[bool]] = None,
+                 batch_size: Optional[pulumi.Input[int]] = None,
+                 batch_window_ms: Optional[pulumi.Input[int]] = None,
+                 default_metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 default_tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 fail_fast: Optional[pulumi.Input[bool]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 provenance: Optional[pulumi.Input[bool]] = None,
+                 strict_tags: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Provider resource.
         """
         pulumi.set(__self__, "api_key", api_key)
         pulumi.set(__self__, "host", host)
+        if allowed_tags is not None:
+            pulumi.set(__self__, "allowed_tags", allowed_tags)
+        if batch_creates is not None:
+            pulumi.set(__self__, "batch_creates", batch_creates)
+        if batch_size is not None:
+            pulumi.set(__self__, "batch_size", batch_size)
+        if batch_window_ms is not None:
+            pulumi.set(__self__, "batch_window_ms", batch_window_ms)
+        if default_metadata is not None:
+            pulumi.set(__self__, "default_metadata", default_metadata)
+        if default_tags is not None:
+            pulumi.set(__self__, "default_tags", default_tags)
+        if fail_fast is not None:
+            pulumi.set(__self__, "fail_fast", fail_fast)
+        if ignore_metadata_keys is not None:
+            pulumi.set(__self__, "ignore_metadata_keys", ignore_metadata_keys)
+        if provenance is not None:
+            pulumi.set(__self__, "provenance", provenance)
+        if strict_tags is not None:
+            pulumi.set(__self__, "strict_tags", strict_tags)
 
     @property
     @pulumi.getter(name="apiKey")
@@ -45,14 +75,114 @@ class ProviderArgs:
     def host(self, value: pulumi.Input[str]):
         pulumi.set(self, "host", value)
 
+    @property
+    @pulumi.getter(name="allowedTags")
+    def allowed_tags(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        return pulumi.get(self, "allowed_tags")
+
+    @allowed_tags.setter
+    def allowed_tags(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "allowed_tags", value)
+
+    @property
+    @pulumi.getter(name="batchCreates")
+    def batch_creates(self) -> Optional[pulumi.Input[bool]]:
+        return pulumi.get(self, "batch_creates")
+
+    @batch_creates.setter
+    def batch_creates(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "batch_creates", value)
+
+    @property
+    @pulumi.getter(name="batchSize")
+    def batch_size(self) -> Optional[pulumi.Input[int]]:
+        return pulumi.get(self, "batch_size")
+
+    @batch_size.setter
+    def batch_size(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "batch_size", value)
+
+    @property
+    @pulumi.getter(name="batchWindowMs")
+    def batch_window_ms(self) -> Optional[pulumi.Input[int]]:
+        return pulumi.get(self, "batch_window_ms")
+
+    @batch_window_ms.setter
+    def batch_window_ms(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "batch_window_ms", value)
+
+    @property
+    @pulumi.getter(name="defaultMetadata")
+    def default_metadata(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        return pulumi.get(self, "default_metadata")
+
+    @default_metadata.setter
+    def default_metadata(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "default_metadata", value)
+
+    @property
+    @pulumi.getter(name="defaultTags")
+    def default_tags(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        return pulumi.get(self, "default_tags")
+
+    @default_tags.setter
+    def default_tags(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "default_tags", value)
+
+    @property
+    @pulumi.getter(name="failFast")
+    def fail_fast(self) -> Optional[pulumi.Input[bool]]:
+        return pulumi.get(self, "fail_fast")
+
+    @fail_fast.setter
+    def fail_fast(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "fail_fast", value)
+
+    @property
+    @pulumi.getter(name="ignoreMetadataKeys")
+    def ignore_metadata_keys(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        return pulumi.get(self, "ignore_metadata_keys")
+
+    @ignore_metadata_keys.setter
+    def ignore_metadata_keys(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "ignore_metadata_keys", value)
+
+    @property
+    @pulumi.getter
+    def provenance(self) -> Optional[pulumi.Input[bool]]:
+        return pulumi.get(self, "provenance")
+
+    @provenance.setter
+    def provenance(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "provenance", value)
+
+    @property
+    @pulumi.getter(name="strictTags")
+    def strict_tags(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "strict_tags")
+
+    @strict_tags.setter
+    def strict_tags(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "strict_tags", value)
+
 
 class Provider(pulumi.ProviderResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  api_key: Optional[pulumi.Input[str]] = None,
+                 batch_creates: Optional[pulumi.Input[bool]] = None,
+                 batch_size: Optional[pulumi.Input[int]] = None,
+                 batch_window_ms: Optional[pulumi.Input[int]] = None,
+                 default_metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 default_tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 fail_fast: Optional[pulumi.Input[bool]] = None,
                  host: Optional[pulumi.Input[str]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 provenance: Optional[pulumi.Input[bool]] = None,
+                 strict_tags: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
         Create a Marmot resource with the given unique name, props, and options.
@@ -82,8 +212,18 @@ class Provider(pulumi.ProviderResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  api_key: Optional[pulumi.Input[str]] = None,
+                 batch_creates: Optional[pulumi.Input[bool]] = None,
+                 batch_size: Optional[pulumi.Input[int]] = None,
+                 batch_window_ms: Optional[pulumi.Input[int]] = None,
+                 default_metadata: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 default_tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 fail_fast: Optional[pulumi.Input[bool]] = None,
                  host: Optional[pulumi.Input[str]] = None,
+                 ignore_metadata_keys: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 provenance: Optional[pulumi.Input[bool]] = None,
+                 strict_tags: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -93,12 +233,22 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
+            __props__.__dict__["allowed_tags"] = pulumi.Output.from_input(allowed_tags).apply(pulumi.runtime.to_json) if allowed_tags is not None else None
             if api_key is None and not opts.urn:
                 raise TypeError("Missing required property 'api_key'")
             __props__.__dict__["api_key"] = api_key
+            __props__.__dict__["batch_creates"] = pulumi.Output.from_input(batch_creates).apply(pulumi.runtime.to_json) if batch_creates is not None else None
+            __props__.__dict__["batch_size"] = pulumi.Output.from_input(batch_size).apply(pulumi.runtime.to_json) if batch_size is not None else None
+            __props__.__dict__["batch_window_ms"] = pulumi.Output.from_input(batch_window_ms).apply(pulumi.runtime.to_json) if batch_window_ms is not None else None
+            __props__.__dict__["default_metadata"] = pulumi.Output.from_input(default_metadata).apply(pulumi.runtime.to_json) if default_metadata is not None else None
+            __props__.__dict__["default_tags"] = pulumi.Output.from_input(default_tags).apply(pulumi.runtime.to_json) if default_tags is not None else None
+            __props__.__dict__["fail_fast"] = pulumi.Output.from_input(fail_fast).apply(pulumi.runtime.to_json) if fail_fast is not None else None
             if host is None and not opts.urn:
                 raise TypeError("Missing required property 'host'")
             __props__.__dict__["host"] = host
+            __props__.__dict__["ignore_metadata_keys"] = pulumi.Output.from_input(ignore_metadata_keys).apply(pulumi.runtime.to_json) if ignore_metadata_keys is not None else None
+            __props__.__dict__["provenance"] = pulumi.Output.from_input(provenance).apply(pulumi.runtime.to_json) if provenance is not None else None
+            __props__.__dict__["strict_tags"] = strict_tags
         super(Provider, __self__).__init__(
             'marmot',
             resource_name,
@@ -115,3 +265,8 @@ class Provider(pulumi.ProviderResource):
     def host(self) -> pulumi.Output[str]:
         return pulumi.get(self, "host")
 
+    @property
+    @pulumi.getter(name="strictTags")
+    def strict_tags(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "strict_tags")
+
@@ -0,0 +1,119 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'SearchAssetsResult',
+    'AwaitableSearchAssetsResult',
+    'search_assets',
+    'search_assets_output',
+]
+
+@pulumi.output_type
+class SearchAssetsResult:
+    def __init__(__self__, assets=None, filters=None, total=None):
+        if assets and not isinstance(assets, list):
+            raise TypeError("Expected argument 'assets' to be a list")
+        pulumi.set(__self__, "assets", assets)
+        if filters and not isinstance(filters, dict):
+            raise TypeError("Expected argument 'filters' to be a dict")
+        pulumi.set(__self__, "filters", filters)
+        if total and not isinstance(total, int):
+            raise TypeError("Expected argument 'total' to be a int")
+        pulumi.set(__self__, "total", total)
+
+    @property
+    @pulumi.getter
+    def assets(self) -> Sequence['outputs.CatalogAsset']:
+        return pulumi.get(self, "assets")
+
+    @property
+    @pulumi.getter
+    def filters(self) -> 'outputs.AssetFilters':
+        return pulumi.get(self, "filters")
+
+    @property
+    @pulumi.getter
+    def total(self) -> int:
+        return pulumi.get(self, "total")
+
+
+class AwaitableSearchAssetsResult(SearchAssetsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return SearchAssetsResult(
+            assets=self.assets,
+            filters=self.filters,
+            total=self.total)
+
+
+def search_assets(calculate_counts: Optional[bool] = None,
+                  limit: Optional[int] = None,
+                  max_results: Optional[int] = None,
+                  offset: Optional[int] = None,
+                  query: Optional[str] = None,
+                  services: Optional[Sequence[str]] = None,
+                  tags: Optional[Sequence[str]] = None,
+                  types: Optional[Sequence[str]] = None,
+                  opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableSearchAssetsResult:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['calculateCounts'] = calculate_counts
+    __args__['limit'] = limit
+    __args__['maxResults'] = max_results
+    __args__['offset'] = offset
+    __args__['query'] = query
+    __args__['services'] = services
+    __args__['tags'] = tags
+    __args__['types'] = types
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('marmot:index:searchAssets', __args__, opts=opts, typ=SearchAssetsResult).value
+
+    return AwaitableSearchAssetsResult(
+        assets=pulumi.get(__ret__, 'assets'),
+        filters=pulumi.get(__ret__, 'filters'),
+        total=pulumi.get(__ret__, 'total'))
+def search_assets_output(calculate_counts: Optional[pulumi.Input[Optional[bool]]] = None,
+                         limit: Optional[pulumi.Input[Optional[int]]] = None,
+                         max_results: Optional[pulumi.Input[Optional[int]]] = None,
+                         offset: Optional[pulumi.Input[Optional[int]]] = None,
+                         query: Optional[pulumi.Input[Optional[str]]] = None,
+                         services: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                         tags: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                         types: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                         opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[SearchAssetsResult]:
+    """
+    Use this data source to access information about an existing resource.
+    """
+    __args__ = dict()
+    __args__['calculateCounts'] = calculate_counts
+    __args__['limit'] = limit
+    __args__['maxResults'] = max_results
+    __args__['offset'] = offset
+    __args__['query'] = query
+    __args__['services'] = services
+    __args__['tags'] = tags
+    __args__['types'] = types
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('marmot:index:searchAssets', __args__, opts=opts, typ=SearchAssetsResult)
+    return __ret__.apply(lambda __response__: SearchAssetsResult(
+        assets=pulumi.get(__response__, 'assets'),
+        filters=pulumi.get(__response__, 'filters'),
+        total=pulumi.get(__response__, 'total')))
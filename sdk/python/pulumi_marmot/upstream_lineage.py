@@ -0,0 +1,173 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = ['UpstreamLineageArgs', 'UpstreamLineage']
+
+@pulumi.input_type
+class UpstreamLineageArgs:
+    def __init__(__self__, *,
+                 sources: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 target: pulumi.Input[str],
+                 job_mrn: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a UpstreamLineage resource.
+        """
+        pulumi.set(__self__, "sources", sources)
+        pulumi.set(__self__, "target", target)
+        if job_mrn is not None:
+            pulumi.set(__self__, "job_mrn", job_mrn)
+
+    @property
+    @pulumi.getter
+    def sources(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        return pulumi.get(self, "sources")
+
+    @sources.setter
+    def sources(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "sources", value)
+
+    @property
+    @pulumi.getter
+    def target(self) -> pulumi.Input[str]:
+        return pulumi.get(self, "target")
+
+    @target.setter
+    def target(self, value: pulumi.Input[str]):
+        pulumi.set(self, "target", value)
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "job_mrn")
+
+    @job_mrn.setter
+    def job_mrn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "job_mrn", value)
+
+
+class UpstreamLineage(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 job_mrn: Optional[pulumi.Input[str]] = None,
+                 sources: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 target: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Create a UpstreamLineage resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: UpstreamLineageArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a UpstreamLineage resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param UpstreamLineageArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(UpstreamLineageArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 job_mrn: Optional[pulumi.Input[str]] = None,
+                 sources: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 target: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = UpstreamLineageArgs.__new__(UpstreamLineageArgs)
+
+            __props__.__dict__["job_mrn"] = job_mrn
+            if sources is None and not opts.urn:
+                raise TypeError("Missing required property 'sources'")
+            __props__.__dict__["sources"] = sources
+            if target is None and not opts.urn:
+                raise TypeError("Missing required property 'target'")
+            __props__.__dict__["target"] = target
+            __props__.__dict__["edges"] = None
+            __props__.__dict__["target_id"] = None
+        super(UpstreamLineage, __self__).__init__(
+            'marmot:index:UpstreamLineage',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'UpstreamLineage':
+        """
+        Get an existing UpstreamLineage resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = UpstreamLineageArgs.__new__(UpstreamLineageArgs)
+
+        __props__.__dict__["edges"] = None
+        __props__.__dict__["job_mrn"] = None
+        __props__.__dict__["sources"] = None
+        __props__.__dict__["target"] = None
+        __props__.__dict__["target_id"] = None
+        return UpstreamLineage(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def edges(self) -> pulumi.Output[Mapping[str, 'outputs.UpstreamEdge']]:
+        return pulumi.get(self, "edges")
+
+    @property
+    @pulumi.getter(name="jobMrn")
+    def job_mrn(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "job_mrn")
+
+    @property
+    @pulumi.getter
+    def sources(self) -> pulumi.Output[Sequence[str]]:
+        return pulumi.get(self, "sources")
+
+    @property
+    @pulumi.getter
+    def target(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "target")
+
+    @property
+    @pulumi.getter(name="targetId")
+    def target_id(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "target_id")
+
@@ -0,0 +1,339 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectionItem(name string, schema map[string]interface{}) map[string]interface{} {
+	item := map[string]interface{}{
+		"name":     name,
+		"type":     "Table",
+		"services": []interface{}{"PostgreSQL"},
+	}
+	if schema != nil {
+		item["schema"] = schema
+	}
+	return item
+}
+
+// serveAssetBatch fakes the batch endpoint, recording each request and
+// failing assets whose name is "broken". The catalog already holds an asset
+// named "existing", which the single create endpoint rejects, and an asset
+// named "concurrent" appears between the lookup and the batch.
+func serveAssetBatch(t *testing.T, mux *http.ServeMux) func() []map[string]interface{} {
+	var mu sync.Mutex
+	var requests []map[string]interface{}
+	mux.HandleFunc("GET /api/v1/assets/lookup/{type}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("name") != "existing" {
+			writeJSON(t, w, http.StatusNotFound, map[string]interface{}{"error": "asset not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, catalogAsset("id-existing", "table", "postgresql", "existing", nil))
+	})
+	mux.HandleFunc("POST /api/v1/assets", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusConflict, map[string]interface{}{"error": "asset already exists"})
+	})
+	mux.HandleFunc("POST /api/v1/assets/batch", func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		mu.Lock()
+		requests = append(requests, request)
+		mu.Unlock()
+
+		results := []map[string]interface{}{}
+		for _, a := range request["assets"].([]interface{}) {
+			asset := a.(map[string]interface{})
+			name := asset["name"].(string)
+			if name == "broken" {
+				results = append(results, map[string]interface{}{"status": "failed", "error": "invalid asset"})
+				continue
+			}
+			// The batch endpoint upserts, so an existing asset sent here
+			// would be overwritten.
+			assert.NotEqual(t, "existing", name, "existing asset was modified by the batch upsert")
+			status := "created"
+			if name == "concurrent" {
+				status = "updated"
+			}
+			results = append(results, map[string]interface{}{
+				"status": status,
+				"asset": map[string]interface{}{
+					"id":        "id-" + name,
+					"mrn":       "mrn://table/postgresql/" + name,
+					"name":      name,
+					"type":      asset["type"],
+					"providers": asset["providers"],
+				},
+			})
+		}
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"assets": results})
+	})
+	return func() []map[string]interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func batchAssetNames(request map[string]interface{}) []string {
+	var names []string
+	for _, asset := range request["assets"].([]interface{}) {
+		names = append(names, asset.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestAssetCollectionDiff(t *testing.T) {
+	prov := provider()
+
+	field := func(name, typ string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "type": typ}
+	}
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"assets": map[string]interface{}{
+			"orders":    collectionItem("orders", map[string]interface{}{"fields": []interface{}{field("id", "int")}}),
+			"customers": collectionItem("customers", nil),
+			"legacy":    collectionItem("legacy", nil),
+		},
+		"mrns":        map[string]interface{}{},
+		"resourceIds": map[string]interface{}{},
+	})
+	news := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"assets": map[string]interface{}{
+			"orders":    collectionItem("orders", map[string]interface{}{"fields": []interface{}{field("id", "bigint")}}),
+			"customers": collectionItem("customers", nil),
+			"payments":  collectionItem("payments", nil),
+		},
+	})
+
+	response, err := prov.Diff(p.DiffRequest{
+		ID:   "catalog",
+		Urn:  urn("AssetCollection"),
+		Olds: olds,
+		News: news,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, response.HasChanges)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		`assets["orders"].schema.fields[0].type`: {Kind: p.Update},
+		`assets["legacy"]`:                       {Kind: p.Delete},
+		`assets["payments"]`:                     {Kind: p.Add},
+	}, response.DetailedDiff)
+}
+
+func TestAssetCollectionCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	requests := serveAssetBatch(t, mux)
+	prov := configuredProvider(t, mux, nil)
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("AssetCollection"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"assets": map[string]interface{}{
+				"orders":    collectionItem("orders", nil),
+				"customers": collectionItem("customers", nil),
+			},
+			"config": map[string]interface{}{"host": "db.internal", "port": 5432},
+		}),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, requests(), 1)
+	assert.Equal(t, []string{"customers", "orders"}, batchAssetNames(requests()[0]))
+	assert.Equal(t, map[string]interface{}{"host": "db.internal", "port": 5432.0}, requests()[0]["config"])
+
+	assert.Equal(t, resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"orders":    "mrn://table/postgresql/orders",
+		"customers": "mrn://table/postgresql/customers",
+	})), response.Properties["mrns"])
+	assert.Equal(t, "id-orders", response.Properties["resourceIds"].ObjectValue()["orders"].StringValue())
+}
+
+func TestAssetCollectionUpdate(t *testing.T) {
+	var deleted []string
+	mux := http.NewServeMux()
+	requests := serveAssetBatch(t, mux)
+	mux.HandleFunc("DELETE /api/v1/assets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"assets": map[string]interface{}{
+			"orders":    collectionItem("orders", nil),
+			"customers": collectionItem("customers", nil),
+			"legacy":    collectionItem("legacy", nil),
+		},
+		"mrns": map[string]interface{}{
+			"orders":    "mrn://table/postgresql/orders",
+			"customers": "mrn://table/postgresql/customers",
+			"legacy":    "mrn://table/postgresql/legacy",
+		},
+		"resourceIds": map[string]interface{}{
+			"orders":    "id-orders",
+			"customers": "id-customers",
+			"legacy":    "id-legacy",
+		},
+	})
+
+	orders := collectionItem("orders", nil)
+	orders["description"] = "All orders"
+	customers := collectionItem("clients", nil)
+	response, err := prov.Update(p.UpdateRequest{
+		ID:   "catalog",
+		Urn:  urn("AssetCollection"),
+		Olds: olds,
+		News: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"assets": map[string]interface{}{
+				"orders":    orders,
+				"customers": customers,
+				"invoices":  collectionItem("invoices", nil),
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, requests(), 1)
+	assert.Equal(t, []string{"clients", "invoices", "orders"}, batchAssetNames(requests()[0]))
+
+	// The legacy key was removed and the customers key now points to a new
+	// asset, so both previous assets are deleted.
+	sort.Strings(deleted)
+	assert.Equal(t, []string{"id-customers", "id-legacy"}, deleted)
+
+	mrns := response.Properties["mrns"].ObjectValue()
+	assert.Len(t, mrns, 3)
+	assert.Equal(t, "mrn://table/postgresql/clients", mrns["customers"].StringValue())
+	assert.Equal(t, "mrn://table/postgresql/invoices", mrns["invoices"].StringValue())
+}
+
+func TestAssetCollectionCreatePartialFailure(t *testing.T) {
+	mux := http.NewServeMux()
+	serveAssetBatch(t, mux)
+	prov := configuredProvider(t, mux, nil)
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("AssetCollection"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"assets": map[string]interface{}{
+				"orders": collectionItem("orders", nil),
+				"bad":    collectionItem("broken", nil),
+			},
+		}),
+	})
+	require.Error(t, err)
+
+	// The created asset is kept in state, while the failed one is retried on
+	// the next update.
+	require.NotNil(t, response.PartialState)
+	assert.Equal(t, []string{`applying asset "bad": creating asset "broken": invalid asset`}, response.PartialState.Reasons)
+	assert.Equal(t, resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"orders": "mrn://table/postgresql/orders",
+	})), response.Properties["mrns"])
+	assert.False(t, response.Properties["assets"].ObjectValue().HasValue("bad"))
+}
+
+func TestAssetCollectionIgnoresMetadataKeys(t *testing.T) {
+	mux := http.NewServeMux()
+	requests := serveAssetBatch(t, mux)
+	mux.HandleFunc("GET /api/v1/assets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "id-orders", r.PathValue("id"))
+		writeJSON(t, w, http.StatusOK, catalogAsset("id-orders", "table", "postgresql", "orders", map[string]interface{}{
+			"owner":         "data-team",
+			"quality_score": 0.9,
+		}))
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	old := collectionItem("orders", nil)
+	old["metadata"] = map[string]interface{}{"owner": "data-team", "quality_score": 0.5}
+	old["ignoreMetadataKeys"] = []interface{}{"quality_*"}
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"assets":      map[string]interface{}{"orders": old},
+		"mrns":        map[string]interface{}{"orders": "mrn://table/postgresql/orders"},
+		"resourceIds": map[string]interface{}{"orders": "id-orders"},
+	})
+	orders := collectionItem("orders", nil)
+	orders["metadata"] = map[string]interface{}{"owner": "platform-team"}
+	orders["ignoreMetadataKeys"] = []interface{}{"quality_*"}
+	news := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"assets": map[string]interface{}{"orders": orders},
+	})
+
+	diff, err := prov.Diff(p.DiffRequest{ID: "catalog", Urn: urn("AssetCollection"), Olds: olds, News: news})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		`assets["orders"].metadata["owner"]`: {Kind: p.Update},
+	}, diff.DetailedDiff)
+
+	_, err = prov.Update(p.UpdateRequest{ID: "catalog", Urn: urn("AssetCollection"), Olds: olds, News: news})
+	require.NoError(t, err)
+
+	// The server's value for the ignored key is sent back unchanged.
+	require.Len(t, requests(), 1)
+	asset := requests()[0]["assets"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"owner": "platform-team", "quality_score": 0.9}, asset["metadata"])
+}
+
+func TestAssetCollectionCreateRejectsExistingAssets(t *testing.T) {
+	mux := http.NewServeMux()
+	requests := serveAssetBatch(t, mux)
+	prov := configuredProvider(t, mux, nil)
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("AssetCollection"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"assets": map[string]interface{}{
+				"orders":     collectionItem("orders", nil),
+				"existing":   collectionItem("existing", nil),
+				"concurrent": collectionItem("concurrent", nil),
+			},
+		}),
+	})
+	require.Error(t, err)
+
+	// Neither asset is adopted, so neither is ever deleted by the collection.
+	require.Len(t, requests(), 1)
+	assert.Equal(t, []string{"concurrent", "orders"}, batchAssetNames(requests()[0]))
+	require.NotNil(t, response.PartialState)
+	assert.Equal(t, []string{
+		`applying asset "existing": creating asset "existing": asset already exists, import it instead`,
+		`applying asset "concurrent": asset id-concurrent already exists (status "updated"), import it instead`,
+	}, response.PartialState.Reasons)
+	assert.Equal(t, resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"orders": "id-orders",
+	})), response.Properties["resourceIds"])
+}
+
+func TestAssetCollectionCreateUsesBatchSize(t *testing.T) {
+	mux := http.NewServeMux()
+	requests := serveAssetBatch(t, mux)
+	prov := configuredProvider(t, mux, map[string]interface{}{"batchSize": 2})
+
+	_, err := prov.Create(p.CreateRequest{
+		Urn: urn("AssetCollection"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"assets": map[string]interface{}{
+				"customers": collectionItem("customers", nil),
+				"invoices":  collectionItem("invoices", nil),
+				"orders":    collectionItem("orders", nil),
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, requests(), 2)
+	assert.Equal(t, []string{"customers", "invoices"}, batchAssetNames(requests()[0]))
+	assert.Equal(t, []string{"orders"}, batchAssetNames(requests()[1]))
+}
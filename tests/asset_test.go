@@ -0,0 +1,586 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	marmot "github.com/marmotdata/pulumi-marmot/provider"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assetInputs(schema, env resource.PropertyMap, sources []resource.PropertyValue) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name":     "orders",
+		"type":     "Topic",
+		"services": []interface{}{"Kafka"},
+		"schema":   schema,
+		"sources":  sources,
+		"environments": map[string]interface{}{
+			"prod": env,
+		},
+	})
+}
+
+// assetState extends inputs with the computed outputs of a created asset.
+func assetState(inputs resource.PropertyMap) resource.PropertyMap {
+	state := inputs.Copy()
+	state["resourceId"] = resource.NewStringProperty("asset-id")
+	state["mrn"] = resource.NewStringProperty("mrn://topic/kafka/orders")
+	state["createdAt"] = resource.NewStringProperty("2024-01-01T00:00:00Z")
+	state["createdBy"] = resource.NewStringProperty("pulumi")
+	state["updatedAt"] = resource.NewStringProperty("2024-01-01T00:00:00Z")
+	return state
+}
+
+func TestAssetDiffNested(t *testing.T) {
+	prov := provider()
+
+	field := func(name, typ string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "type": typ}
+	}
+	olds := assetInputs(
+		resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":   "Order",
+			"fields": []interface{}{field("id", "string"), field("total", "int")},
+		}),
+		resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":     "prod",
+			"path":     "/prod",
+			"metadata": map[string]interface{}{"region": "eu-west-1"},
+		}),
+		[]resource.PropertyValue{
+			resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{"name": "kafka", "priority": 1})),
+			resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{"name": "glue", "priority": 2})),
+		},
+	)
+	news := assetInputs(
+		resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":   "Order",
+			"fields": []interface{}{field("id", "string"), field("total", "double"), field("currency", "string")},
+		}),
+		resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":     "prod",
+			"path":     "/prod",
+			"metadata": map[string]interface{}{"region": "us-east-1", "owner": "payments"},
+		}),
+		[]resource.PropertyValue{
+			resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{"name": "kafka", "priority": 1})),
+			resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{"name": "glue", "priority": 5})),
+		},
+	)
+
+	response, err := prov.Diff(p.DiffRequest{
+		ID:   "asset-id",
+		Urn:  urn("Asset"),
+		Olds: assetState(olds),
+		News: news,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, response.HasChanges)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"schema.fields[1].type":                {Kind: p.Update},
+		"schema.fields[2]":                     {Kind: p.Add},
+		"sources[1].priority":                  {Kind: p.Update},
+		`environments["prod"].metadata.region`: {Kind: p.Update},
+		`environments["prod"].metadata.owner`:  {Kind: p.Add},
+	}, response.DetailedDiff)
+}
+
+func TestAssetDiffNoChanges(t *testing.T) {
+	prov := provider()
+
+	inputs := assetInputs(
+		resource.NewPropertyMapFromMap(map[string]interface{}{"name": "Order"}),
+		resource.NewPropertyMapFromMap(map[string]interface{}{"name": "prod", "path": "/prod"}),
+		nil,
+	)
+
+	response, err := prov.Diff(p.DiffRequest{
+		ID:   "asset-id",
+		Urn:  urn("Asset"),
+		Olds: assetState(inputs),
+		News: inputs,
+	})
+	require.NoError(t, err)
+	assert.False(t, response.HasChanges)
+	assert.Empty(t, response.DetailedDiff)
+}
+
+func TestAssetCreatePreviewMRN(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      string
+		services []interface{}
+		expected string
+	}{
+		{"customer-events-stream", "Topic", []interface{}{"Kafka"}, "mrn://topic/kafka/customer-events-stream"},
+		{"Orders_2024", "Table", []interface{}{"PostgreSQL", "Glue"}, "mrn://table/postgresql/Orders_2024"},
+		{"analytics.public.orders", "Database", []interface{}{"BigQuery"}, "mrn://database/bigquery/analytics.public.orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			prov := provider()
+
+			response, err := prov.Create(p.CreateRequest{
+				Urn: urn("Asset"),
+				Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+					"name":     tt.name,
+					"type":     tt.typ,
+					"services": tt.services,
+				}),
+				Preview: true,
+			})
+			require.NoError(t, err)
+
+			mrn := response.Properties["mrn"]
+			require.True(t, mrn.IsString(), "mrn should be known during preview, got %v", mrn)
+			assert.Equal(t, tt.expected, mrn.StringValue())
+		})
+	}
+}
+
+func TestAssetUpdatePreviewMRN(t *testing.T) {
+	prov := provider()
+
+	olds := assetState(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name":     "orders",
+		"type":     "Topic",
+		"services": []interface{}{"Kafka"},
+	}))
+	preview := func(news map[string]interface{}) resource.PropertyValue {
+		response, err := prov.Update(p.UpdateRequest{
+			ID:      "asset-id",
+			Urn:     urn("Asset"),
+			Olds:    olds,
+			News:    resource.NewPropertyMapFromMap(news),
+			Preview: true,
+		})
+		require.NoError(t, err)
+		return response.Properties["mrn"]
+	}
+
+	// A changed name, type or service is previewed with the MRN derived from
+	// the new values.
+	mrn := preview(map[string]interface{}{
+		"name":     "Orders_Archive",
+		"type":     "View",
+		"services": []interface{}{"Snowflake", "PostgreSQL"},
+	})
+	require.True(t, mrn.IsString(), "mrn should be known during preview, got %v", mrn)
+	assert.Equal(t, "mrn://view/snowflake/Orders_Archive", mrn.StringValue())
+
+	// Otherwise the current MRN is kept.
+	mrn = preview(map[string]interface{}{
+		"name":        "orders",
+		"type":        "Topic",
+		"services":    []interface{}{"Kafka"},
+		"description": "All orders",
+	})
+	assert.Equal(t, "mrn://topic/kafka/orders", mrn.StringValue())
+}
+
+func TestAssetCreatePreviewMRNUnknownName(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("Asset"),
+		Properties: resource.PropertyMap{
+			"name":     resource.MakeComputed(resource.NewStringProperty("")),
+			"type":     resource.NewStringProperty("Topic"),
+			"services": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("Kafka")}),
+		},
+		Preview: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, response.Properties["mrn"].IsComputed())
+}
+
+func TestAssetCheckMergesProviderDefaults(t *testing.T) {
+	prov := provider()
+
+	err := prov.Configure(p.ConfigureRequest{
+		Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"host":        "http://localhost:8080",
+			"apiKey":      "test",
+			"defaultTags": []interface{}{"managed-by-pulumi", "customer-data"},
+			"defaultMetadata": map[string]interface{}{
+				"team":        "platform",
+				"cost_center": "cc-42",
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("Asset"),
+		News: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":     "orders",
+			"type":     "Topic",
+			"services": []interface{}{"Kafka"},
+			"tags":     []interface{}{"customer-data", "orders"},
+			"metadata": map[string]interface{}{"team": "payments"},
+		}),
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("customer-data"),
+		resource.NewStringProperty("orders"),
+		resource.NewStringProperty("managed-by-pulumi"),
+	}), response.Inputs["tags"])
+	assert.Equal(t, resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"team":        "payments",
+		"cost_center": "cc-42",
+	})), response.Inputs["metadata"])
+}
+
+func TestAssetDiffIgnoresMetadataKeys(t *testing.T) {
+	prov := provider()
+
+	inputs := func(metadata map[string]interface{}) resource.PropertyMap {
+		return resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":               "orders",
+			"type":               "Table",
+			"services":           []interface{}{"PostgreSQL"},
+			"metadata":           metadata,
+			"ignoreMetadataKeys": []interface{}{"row_*", "partitions"},
+		})
+	}
+
+	response, err := prov.Diff(p.DiffRequest{
+		ID:  "asset-id",
+		Urn: urn("Asset"),
+		Olds: assetState(inputs(map[string]interface{}{
+			"owner":      "platform-team",
+			"row_count":  "1200",
+			"partitions": "12",
+		})),
+		News: inputs(map[string]interface{}{
+			"owner":      "data-team",
+			"partitions": "24",
+		}),
+	})
+	require.NoError(t, err)
+
+	assert.True(t, response.HasChanges)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		`metadata["owner"]`: {Kind: p.Update},
+	}, response.DetailedDiff)
+}
+
+func TestAssetCreateWithProvenance(t *testing.T) {
+	var created map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/assets", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+		writeJSON(t, w, http.StatusCreated, map[string]interface{}{
+			"id":        "asset-id",
+			"mrn":       "mrn://topic/kafka/orders",
+			"name":      created["name"],
+			"type":      created["type"],
+			"providers": created["providers"],
+			"metadata":  created["metadata"],
+		})
+	})
+	prov := configuredProvider(t, mux, map[string]interface{}{"provenance": true})
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("Asset"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":     "orders",
+			"type":     "Topic",
+			"services": []interface{}{"Kafka"},
+			"metadata": map[string]interface{}{"owner": "platform-team"},
+		}),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"owner":                   "platform-team",
+		"pulumi_project":          "proj",
+		"pulumi_stack":            "stack",
+		"pulumi_urn":              string(urn("Asset")),
+		"pulumi_provider_version": "",
+	}, created["metadata"])
+	assert.Equal(t, resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"owner": "platform-team",
+	})), response.Properties["metadata"])
+}
+
+func TestAssetCheckStrictTags(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/suggestions/tags", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, []string{"pii", "orders", "customer-data"})
+	})
+	check := func(t *testing.T, mode string, tags ...interface{}) p.CheckResponse {
+		prov := configuredProvider(t, mux, map[string]interface{}{
+			"strictTags":  mode,
+			"allowedTags": []interface{}{"experimental"},
+		})
+		response, err := prov.Check(p.CheckRequest{
+			Urn: urn("Asset"),
+			News: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":     "orders",
+				"type":     "Topic",
+				"services": []interface{}{"Kafka"},
+				"tags":     tags,
+			}),
+		})
+		require.NoError(t, err)
+		return response
+	}
+
+	t.Run("error", func(t *testing.T) {
+		response := check(t, "error", "orders", "experimental", "PII", "p-i-i", "customer_dta", "zzz")
+		assert.Equal(t, []p.CheckFailure{
+			{Property: "tags", Reason: `tag "PII" is not in the catalog's tag vocabulary, did you mean "pii"?`},
+			{Property: "tags", Reason: `tag "p-i-i" is not in the catalog's tag vocabulary, did you mean "pii"?`},
+			{Property: "tags", Reason: `tag "customer_dta" is not in the catalog's tag vocabulary, did you mean "customer-data"?`},
+			{Property: "tags", Reason: `tag "zzz" is not in the catalog's tag vocabulary`},
+		}, response.Failures)
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		response := check(t, "warn", "PII")
+		assert.Empty(t, response.Failures)
+	})
+}
+
+func TestAssetCheckStrictTagsReadsVocabularyOnce(t *testing.T) {
+	// The catalog has more tags than are read at once, so a tag missing from
+	// the first page is looked up on its own.
+	page := make([]string, 1000)
+	for i := range page {
+		page[i] = fmt.Sprintf("tag-%d", i)
+	}
+	var mu sync.Mutex
+	var prefixes []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/suggestions/tags", func(w http.ResponseWriter, r *http.Request) {
+		prefix := r.URL.Query().Get("prefix")
+		mu.Lock()
+		prefixes = append(prefixes, prefix)
+		mu.Unlock()
+		switch prefix {
+		case "":
+			writeJSON(t, w, http.StatusOK, page)
+		case "zeta":
+			writeJSON(t, w, http.StatusOK, []string{"zeta", "zeta-2"})
+		default:
+			writeJSON(t, w, http.StatusOK, []string{})
+		}
+	})
+	prov := configuredProvider(t, mux, map[string]interface{}{"strictTags": "error"})
+
+	check := func(tags ...interface{}) p.CheckResponse {
+		response, err := prov.Check(p.CheckRequest{
+			Urn: urn("Asset"),
+			News: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":     "orders",
+				"type":     "Topic",
+				"services": []interface{}{"Kafka"},
+				"tags":     tags,
+			}),
+		})
+		require.NoError(t, err)
+		return response
+	}
+	for i := 0; i < 3; i++ {
+		assert.Empty(t, check("tag-1", "zeta").Failures)
+	}
+	assert.Equal(t, []p.CheckFailure{
+		{Property: "tags", Reason: `tag "zzz" is not in the catalog's tag vocabulary`},
+	}, check("zzz").Failures)
+
+	assert.Equal(t, []string{"", "zeta", "zzz"}, prefixes)
+}
+
+// serveAssetLookup fakes the lookup endpoint, in which only the assets named
+// in existing are found.
+func serveAssetLookup(t *testing.T, mux *http.ServeMux, existing ...string) {
+	mux.HandleFunc("GET /api/v1/assets/lookup/{type}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		if !slices.Contains(existing, name) {
+			writeJSON(t, w, http.StatusNotFound, map[string]interface{}{"error": "asset not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, catalogAsset("id-"+name, "topic", "kafka", name, nil))
+	})
+}
+
+func TestAssetCreateBatches(t *testing.T) {
+	var batches [][]map[string]interface{}
+	var mu sync.Mutex
+	mux := http.NewServeMux()
+	serveAssetLookup(t, mux, "existing")
+	mux.HandleFunc("POST /api/v1/assets", func(w http.ResponseWriter, r *http.Request) {
+		var asset map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&asset))
+		assert.Equal(t, "existing", asset["name"])
+		writeJSON(t, w, http.StatusConflict, map[string]interface{}{"error": "asset already exists"})
+	})
+	mux.HandleFunc("POST /api/v1/assets/batch", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Assets []map[string]interface{} `json:"assets"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		mu.Lock()
+		batches = append(batches, request.Assets)
+		mu.Unlock()
+
+		results := []map[string]interface{}{}
+		for _, asset := range request.Assets {
+			if asset["name"] == "broken" {
+				results = append(results, map[string]interface{}{"status": "failed", "error": "invalid schema"})
+				continue
+			}
+			// The batch endpoint upserts, so an existing asset sent here
+			// would be overwritten.
+			assert.NotEqual(t, "existing", asset["name"], "existing asset was modified by the batch upsert")
+			results = append(results, map[string]interface{}{
+				"status": "created",
+				"asset": map[string]interface{}{
+					"id":        "id-" + asset["name"].(string),
+					"mrn":       "mrn://topic/kafka/" + asset["name"].(string),
+					"name":      asset["name"],
+					"type":      asset["type"],
+					"providers": asset["providers"],
+				},
+			})
+		}
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"assets": results})
+	})
+	prov := configuredProvider(t, mux, map[string]interface{}{
+		"batchCreates":  true,
+		"batchWindowMs": 200,
+	})
+
+	names := []string{"orders", "broken", "payments", "existing"}
+	responses := make([]p.CreateResponse, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i], errs[i] = prov.Create(p.CreateRequest{
+				Urn: urn("Asset"),
+				Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+					"name":     name,
+					"type":     "Topic",
+					"services": []interface{}{"Kafka"},
+				}),
+			})
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0], 3)
+
+	require.NoError(t, errs[0])
+	assert.Equal(t, "id-orders", responses[0].ID)
+	assert.Equal(t, "mrn://topic/kafka/orders", responses[0].Properties["mrn"].StringValue())
+	assert.ErrorContains(t, errs[1], `creating asset "broken": invalid schema`)
+	require.NoError(t, errs[2])
+	assert.Equal(t, "id-payments", responses[2].ID)
+	assert.ErrorContains(t, errs[3], `creating asset "existing": asset already exists, import it instead`)
+}
+
+func TestAssetCreateBatchSkipsCancelledCreates(t *testing.T) {
+	var requests int
+	var mu sync.Mutex
+	mux := http.NewServeMux()
+	serveAssetLookup(t, mux)
+	mux.HandleFunc("POST /api/v1/assets/batch", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"assets": []interface{}{}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	prov := integration.NewServerWithContext(ctx, marmot.Name, semver.MustParse("1.0.0"), marmot.Provider())
+	require.NoError(t, prov.Configure(p.ConfigureRequest{
+		Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"host":          server.URL,
+			"apiKey":        "test",
+			"batchCreates":  true,
+			"batchWindowMs": 100,
+		}),
+	}))
+
+	_, err := prov.Create(p.CreateRequest{
+		Urn: urn("Asset"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":     "orders",
+			"type":     "Topic",
+			"services": []interface{}{"Kafka"},
+		}),
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	time.Sleep(200 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Zero(t, requests)
+}
+
+func TestAssetCreateBatchKeepsSentCreates(t *testing.T) {
+	mux := http.NewServeMux()
+	serveAssetLookup(t, mux)
+	mux.HandleFunc("POST /api/v1/assets/batch", func(w http.ResponseWriter, r *http.Request) {
+		// The Create is cancelled while the batch is in flight.
+		time.Sleep(100 * time.Millisecond)
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"assets": []interface{}{
+			map[string]interface{}{
+				"status": "created",
+				"asset":  catalogAsset("id-orders", "topic", "kafka", "orders", nil),
+			},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	prov := integration.NewServerWithContext(ctx, marmot.Name, semver.MustParse("1.0.0"), marmot.Provider())
+	require.NoError(t, prov.Configure(p.ConfigureRequest{
+		Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"host":          server.URL,
+			"apiKey":        "test",
+			"batchCreates":  true,
+			"batchWindowMs": 10,
+		}),
+	}))
+
+	// The asset was created, so it is returned to be recorded in state.
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("Asset"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"name":     "orders",
+			"type":     "Topic",
+			"services": []interface{}{"Kafka"},
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "id-orders", response.ID)
+}
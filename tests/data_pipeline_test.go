@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	marmot "github.com/marmotdata/pulumi-marmot/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pipelineMocks records the resources registered by the component and reports
+// an MRN for every asset of an AssetCollection.
+type pipelineMocks struct {
+	mu        sync.Mutex
+	resources map[string]resource.PropertyMap
+}
+
+func (m *pipelineMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resources[args.TypeToken] = args.Inputs
+
+	outputs := args.Inputs.Copy()
+	if args.TypeToken == "marmot:index:AssetCollection" {
+		mrns := resource.PropertyMap{}
+		for key, item := range args.Inputs["assets"].ObjectValue() {
+			mrns[key] = resource.NewStringProperty("mrn://" + item.ObjectValue()["type"].StringValue() + "/" + item.ObjectValue()["name"].StringValue())
+		}
+		outputs["mrns"] = resource.NewObjectProperty(mrns)
+	}
+	return args.Name + "-id", outputs, nil
+}
+
+func (m *pipelineMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return resource.PropertyMap{}, nil
+}
+
+func TestDataPipeline(t *testing.T) {
+	mocks := &pipelineMocks{resources: map[string]resource.PropertyMap{}}
+
+	var inputs, outputs []string
+	var job string
+	var edges []map[string]string
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		pipeline, err := marmot.NewDataPipeline(ctx, "etl", marmot.DataPipelineArgs{
+			InputMRNs: pulumi.StringArray{pulumi.String("mrn://kafka/orders")},
+			Inputs:    []marmot.AssetCollectionItem{{Name: "customers", Type: "table", Providers: []string{"postgres"}}},
+			Job:       &marmot.AssetCollectionItem{Name: "etl", Type: "job", Providers: []string{"spark"}},
+			Outputs:   []marmot.AssetCollectionItem{{Name: "orders", Type: "bucket", Providers: []string{"s3"}}},
+		})
+		if err != nil {
+			return err
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(pipeline.InputMRNs, pipeline.JobMRN, pipeline.OutputMRNs, pipeline.Edges).ApplyT(func(values []interface{}) error {
+			inputs, job, outputs = values[0].([]string), values[1].(string), values[2].([]string)
+			edges = values[3].([]map[string]string)
+			wg.Done()
+			return nil
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"mrn://kafka/orders", "mrn://table/customers"}, inputs)
+	assert.Equal(t, "mrn://job/etl", job)
+	assert.Equal(t, []string{"mrn://bucket/orders"}, outputs)
+	assert.Equal(t, []map[string]string{
+		{"source": "mrn://kafka/orders", "target": "mrn://job/etl", "jobMrn": "mrn://job/etl"},
+		{"source": "mrn://table/customers", "target": "mrn://job/etl", "jobMrn": "mrn://job/etl"},
+		{"source": "mrn://job/etl", "target": "mrn://bucket/orders", "jobMrn": "mrn://job/etl"},
+	}, edges)
+
+	assets := mocks.resources["marmot:index:AssetCollection"]["assets"].ObjectValue()
+	assert.ElementsMatch(t, []resource.PropertyKey{"input/customers", "job", "output/orders"}, assets.StableKeys())
+	assert.Len(t, mocks.resources["marmot:index:LineageSet"]["edges"].ArrayValue(), 3)
+}
+
+func TestDataPipelineRequiresOneJob(t *testing.T) {
+	mocks := &pipelineMocks{resources: map[string]resource.PropertyMap{}}
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		_, err := marmot.NewDataPipeline(ctx, "etl", marmot.DataPipelineArgs{
+			Job:    &marmot.AssetCollectionItem{Name: "etl", Type: "job", Providers: []string{"spark"}},
+			JobMRN: pulumi.String("mrn://job/etl"),
+		})
+		return err
+	}, pulumi.WithMocks("project", "stack", mocks))
+	assert.ErrorContains(t, err, "exactly one of job or jobMrn must be set")
+}
+
+func TestDataPipelineRejectsDuplicateAssetNames(t *testing.T) {
+	mocks := &pipelineMocks{resources: map[string]resource.PropertyMap{}}
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		_, err := marmot.NewDataPipeline(ctx, "etl", marmot.DataPipelineArgs{
+			Inputs: []marmot.AssetCollectionItem{
+				{Name: "orders", Type: "table", Providers: []string{"postgres"}},
+				{Name: "orders", Type: "topic", Providers: []string{"kafka"}},
+			},
+			JobMRN: pulumi.String("mrn://job/etl"),
+		})
+		return err
+	}, pulumi.WithMocks("project", "stack", mocks))
+	assert.ErrorContains(t, err, `assets "orders" on postgres (table) and "orders" on kafka (topic) both map to key "input/orders"`)
+	assert.NotContains(t, mocks.resources, "marmot:index:AssetCollection")
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeDoc(t *testing.T, dir, path, content string) {
+	t.Helper()
+	path = filepath.Join(dir, filepath.FromSlash(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+// serveDocumentationBatch fakes the documentation batch endpoint, rejecting
+// documents for MRNs containing "missing".
+func serveDocumentationBatch(t *testing.T, mux *http.ServeMux) *[][]map[string]interface{} {
+	var requests [][]map[string]interface{}
+	mux.HandleFunc("POST /api/v1/assets/documentation/batch", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Documentation []map[string]interface{} `json:"documentation"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request.Documentation)
+
+		results := []map[string]interface{}{}
+		for _, doc := range request.Documentation {
+			if doc["mrn"] == "mrn://table/postgresql/missing" {
+				results = append(results, map[string]interface{}{"status": "failed", "error": "asset not found"})
+				continue
+			}
+			results = append(results, map[string]interface{}{"status": "created", "documentation": doc})
+		}
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"results": results})
+	})
+	return &requests
+}
+
+func TestDocumentationSetSyncsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeDoc(t, dir, "topic/kafka/orders.md", "# Orders")
+	writeDoc(t, dir, "table/postgresql/customers.md", "# Customers")
+	writeDoc(t, dir, "README.txt", "not documentation")
+
+	mux := http.NewServeMux()
+	requests := serveDocumentationBatch(t, mux)
+	prov := configuredProvider(t, mux, nil)
+
+	inputs := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"directory": dir,
+		"source":    "docs-repo",
+		"globalDocs": map[string]interface{}{
+			"topic/kafka/orders.md": []interface{}{"mrn://table/postgresql/orders"},
+		},
+	})
+	created, err := prov.Create(p.CreateRequest{Urn: urn("DocumentationSet"), Properties: inputs})
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	uploaded := (*requests)[0]
+	require.Len(t, uploaded, 2)
+	assert.Equal(t, "mrn://table/postgresql/customers", uploaded[0]["mrn"])
+	assert.Equal(t, "mrn://topic/kafka/orders", uploaded[1]["mrn"])
+	assert.Equal(t, "# Orders", uploaded[1]["content"])
+	assert.Equal(t, "docs-repo", uploaded[1]["source"])
+	assert.Equal(t, []interface{}{"mrn://table/postgresql/orders"}, uploaded[1]["global_docs"])
+
+	documents := created.Properties["documents"].ObjectValue()
+	assert.Len(t, documents, 2)
+	assert.Equal(t, "created", documents["topic/kafka/orders.md"].ObjectValue()["status"].StringValue())
+
+	// Editing one file reports only that file as changed and uploads it alone.
+	writeDoc(t, dir, "topic/kafka/orders.md", "# Orders\n\nAll customer orders.")
+	state := created.Properties.Copy()
+	diff, err := prov.Diff(p.DiffRequest{ID: created.ID, Urn: urn("DocumentationSet"), Olds: state, News: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		`documents["topic/kafka/orders.md"]`: {Kind: p.Update},
+	}, diff.DetailedDiff)
+
+	_, err = prov.Update(p.UpdateRequest{ID: created.ID, Urn: urn("DocumentationSet"), Olds: state, News: inputs})
+	require.NoError(t, err)
+	require.Len(t, *requests, 2)
+	require.Len(t, (*requests)[1], 1)
+	assert.Equal(t, "mrn://topic/kafka/orders", (*requests)[1][0]["mrn"])
+}
+
+func TestDocumentationSetReportsFailedDocuments(t *testing.T) {
+	dir := t.TempDir()
+	writeDoc(t, dir, "orders.md", "# Orders")
+	writeDoc(t, dir, "missing.md", "# Missing")
+
+	mux := http.NewServeMux()
+	serveDocumentationBatch(t, mux)
+	prov := configuredProvider(t, mux, nil)
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("DocumentationSet"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"directory":   dir,
+			"mrnTemplate": "mrn://table/postgresql/{path}",
+		}),
+	})
+	require.Error(t, err)
+	require.NotNil(t, response.PartialState)
+	assert.Equal(t, []string{"uploading missing.md for mrn://table/postgresql/missing: asset not found"}, response.PartialState.Reasons)
+
+	documents := response.Properties["documents"].ObjectValue()
+	assert.Equal(t, "created", documents["orders.md"].ObjectValue()["status"].StringValue())
+	assert.Equal(t, "asset not found", documents["missing.md"].ObjectValue()["error"].StringValue())
+}
+
+func TestDocumentationSetCheckRequiresPathInTemplate(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("DocumentationSet"),
+		News: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"directory":   "docs",
+			"mrnTemplate": "mrn://table/postgresql/orders",
+		}),
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "mrnTemplate", response.Failures[0].Property)
+}
@@ -0,0 +1,528 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// catalogAsset builds an asset as returned by the Marmot API.
+func catalogAsset(id, typ, service, name string, metadata map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        id,
+		"mrn":       "mrn://" + typ + "/" + service + "/" + name,
+		"name":      name,
+		"type":      typ,
+		"providers": []string{service},
+		"metadata":  metadata,
+	}
+}
+
+// serveAssetList serves GET /assets/list from catalog, honouring limit and offset.
+func serveAssetList(t *testing.T, mux *http.ServeMux, catalog []map[string]interface{}) {
+	mux.HandleFunc("GET /api/v1/assets/list", func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := min(offset+limit, len(catalog))
+		page := []map[string]interface{}{}
+		if offset < len(catalog) {
+			page = catalog[offset:end]
+		}
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"assets": page,
+			"total":  len(catalog),
+		})
+	})
+}
+
+func invoke(t *testing.T, prov integration.Server, fn string, args map[string]interface{}) p.InvokeResponse {
+	t.Helper()
+	response, err := prov.Invoke(p.InvokeRequest{
+		Token: tokens.Type("marmot:index:" + fn),
+		Args:  resource.NewPropertyMapFromMap(args),
+	})
+	require.NoError(t, err)
+	return response
+}
+
+func TestListManagedAssets(t *testing.T) {
+	catalog := []map[string]interface{}{}
+	for i := 0; i < 250; i++ {
+		stack := "dev"
+		if i%2 == 0 {
+			stack = "prod"
+		}
+		name := "table_" + strconv.Itoa(i)
+		catalog = append(catalog, catalogAsset(strconv.Itoa(i), "table", "postgresql", name, map[string]interface{}{
+			"pulumi_project": "warehouse",
+			"pulumi_stack":   stack,
+			"pulumi_urn":     "urn:pulumi:" + stack + "::warehouse::marmot:index:Asset::" + name,
+		}))
+	}
+	catalog = append(catalog, catalogAsset("unmanaged", "table", "postgresql", "legacy", nil))
+
+	mux := http.NewServeMux()
+	serveAssetList(t, mux, catalog)
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "listManagedAssets", map[string]interface{}{
+		"stack":   "prod",
+		"project": "warehouse",
+	})
+	require.Empty(t, response.Failures)
+
+	assets := response.Return["assets"].ArrayValue()
+	require.Len(t, assets, 125)
+	first := assets[0].ObjectValue()
+	assert.Equal(t, "0", first["id"].StringValue())
+	assert.Equal(t, "mrn://table/postgresql/table_0", first["mrn"].StringValue())
+	assert.Equal(t, "urn:pulumi:prod::warehouse::marmot:index:Asset::table_0", first["urn"].StringValue())
+}
+
+func TestGetCatalogAsset(t *testing.T) {
+	asset := catalogAsset("asset-id", "table", "postgresql", "orders", map[string]interface{}{"owner": "data-team"})
+	asset["parent_mrn"] = "mrn://database/postgresql/warehouse"
+	asset["tags"] = []string{"pii"}
+	asset["created_at"] = "2024-01-01T00:00:00Z"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "asset-id" {
+			writeJSON(t, w, http.StatusNotFound, map[string]interface{}{"error": "asset not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, asset)
+	})
+	mux.HandleFunc("GET /api/v1/assets/qualified-name/{mrn...}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("mrn") != asset["mrn"] {
+			writeJSON(t, w, http.StatusNotFound, map[string]interface{}{"error": "asset not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, asset)
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	for _, args := range []map[string]interface{}{
+		{"resourceId": "asset-id"},
+		{"mrn": "mrn://table/postgresql/orders"},
+	} {
+		response := invoke(t, prov, "getCatalogAsset", args)
+		require.Empty(t, response.Failures)
+		assert.Equal(t, "asset-id", response.Return["resourceId"].StringValue())
+		assert.Equal(t, "mrn://table/postgresql/orders", response.Return["mrn"].StringValue())
+		assert.Equal(t, "mrn://database/postgresql/warehouse", response.Return["parentMrn"].StringValue())
+		assert.Equal(t, "data-team", response.Return["metadata"].ObjectValue()["owner"].StringValue())
+		assert.Equal(t, "2024-01-01T00:00:00Z", response.Return["createdAt"].StringValue())
+	}
+
+	_, err := prov.Invoke(p.InvokeRequest{
+		Token: "marmot:index:getCatalogAsset",
+		Args:  resource.NewPropertyMapFromMap(map[string]interface{}{}),
+	})
+	assert.ErrorContains(t, err, "exactly one of resourceId or mrn must be set")
+}
+
+func TestLookupAsset(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/lookup/{type}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("type") != "Table" || r.PathValue("name") != "orders" {
+			writeJSON(t, w, http.StatusNotFound, map[string]interface{}{"error": "asset not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, catalogAsset("asset-id", "table", "postgresql", "orders", nil))
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "lookupAsset", map[string]interface{}{"type": "Table", "name": "orders"})
+	require.Empty(t, response.Failures)
+	assert.Equal(t, "mrn://table/postgresql/orders", response.Return["asset"].ObjectValue()["mrn"].StringValue())
+
+	response = invoke(t, prov, "lookupAsset", map[string]interface{}{
+		"type":         "Table",
+		"name":         "customers",
+		"allowMissing": true,
+	})
+	require.Empty(t, response.Failures)
+	assert.Equal(t, resource.PropertyMap{}, response.Return)
+
+	_, err := prov.Invoke(p.InvokeRequest{
+		Token: "marmot:index:lookupAsset",
+		Args:  resource.NewPropertyMapFromMap(map[string]interface{}{"type": "Table", "name": "customers"}),
+	})
+	assert.ErrorContains(t, err, `no asset of type "Table" named "customers" exists in the catalog`)
+}
+
+func TestSearchAssetsPaginates(t *testing.T) {
+	catalog := []map[string]interface{}{}
+	for i := 0; i < 230; i++ {
+		catalog = append(catalog, catalogAsset(strconv.Itoa(i), "topic", "kafka", "events_"+strconv.Itoa(i), nil))
+	}
+
+	var requests []url.Values
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/search", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		requests = append(requests, query)
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		end := min(offset+limit, len(catalog))
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"assets": catalog[offset:end],
+			"total":  len(catalog),
+			"filters": map[string]interface{}{
+				"types":     map[string]int{"Topic": 230},
+				"providers": map[string]int{"Kafka": 230},
+				"tags":      map[string]int{"customer-data": 230},
+			},
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "searchAssets", map[string]interface{}{
+		"query":           "events",
+		"types":           []interface{}{"Topic"},
+		"tags":            []interface{}{"customer-data"},
+		"calculateCounts": true,
+		"limit":           100,
+		"maxResults":      150,
+	})
+	require.Empty(t, response.Failures)
+
+	assets := response.Return["assets"].ArrayValue()
+	require.Len(t, assets, 150)
+	assert.Equal(t, "149", assets[149].ObjectValue()["resourceId"].StringValue())
+	assert.Equal(t, 230.0, response.Return["total"].NumberValue())
+	filters := response.Return["filters"].ObjectValue()
+	assert.Equal(t, 230.0, filters["services"].ObjectValue()["Kafka"].NumberValue())
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, "events", requests[0].Get("q"))
+	assert.Equal(t, []string{"Topic"}, requests[0]["types"])
+	assert.Equal(t, []string{"customer-data"}, requests[0]["tags"])
+	assert.Equal(t, "true", requests[0].Get("calculateCounts"))
+	assert.Equal(t, "100", requests[1].Get("offset"))
+	assert.Equal(t, "50", requests[1].Get("limit"))
+}
+
+func TestListAssetsConcurrently(t *testing.T) {
+	catalog := []map[string]interface{}{}
+	for i := 0; i < 1050; i++ {
+		// Interleave names so that catalog order differs from MRN order.
+		name := fmt.Sprintf("table_%04d", (i*13)%1050)
+		catalog = append(catalog, catalogAsset(strconv.Itoa(i), "table", "postgresql", name, nil))
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	mux := http.NewServeMux()
+	list := http.NewServeMux()
+	serveAssetList(t, list, catalog)
+	mux.HandleFunc("GET /api/v1/assets/list", func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		list.ServeHTTP(w, r)
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "listAssets", map[string]interface{}{
+		"pageSize":    50,
+		"parallelism": 3,
+	})
+	require.Empty(t, response.Failures)
+
+	assets := response.Return["assets"].ArrayValue()
+	require.Len(t, assets, 1050)
+	assert.Equal(t, 1050.0, response.Return["count"].NumberValue())
+	assert.Equal(t, 1050.0, response.Return["total"].NumberValue())
+	assert.Equal(t, "mrn://table/postgresql/table_0000", assets[0].ObjectValue()["mrn"].StringValue())
+	assert.Equal(t, "mrn://table/postgresql/table_1049", assets[1049].ObjectValue()["mrn"].StringValue())
+	for i := 1; i < len(assets); i++ {
+		assert.Less(t, assets[i-1].ObjectValue()["mrn"].StringValue(), assets[i].ObjectValue()["mrn"].StringValue())
+	}
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+}
+
+func TestMatchAssets(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/match-pattern", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Table", r.URL.Query().Get("type"))
+		assert.Equal(t, "orders_*", r.URL.Query().Get("pattern"))
+		writeJSON(t, w, http.StatusOK, []map[string]interface{}{
+			catalogAsset("3", "table", "postgresql", "orders_2025", nil),
+			catalogAsset("1", "table", "postgresql", "orders_2023", nil),
+			catalogAsset("2", "table", "postgresql", "orders_2024", nil),
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "matchAssets", map[string]interface{}{"type": "Table", "pattern": "orders_*"})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyValue([]interface{}{
+		"mrn://table/postgresql/orders_2023",
+		"mrn://table/postgresql/orders_2024",
+		"mrn://table/postgresql/orders_2025",
+	}), response.Return["mrns"])
+	matches := response.Return["matches"].ArrayValue()
+	require.Len(t, matches, 3)
+	assert.Equal(t, "1", matches[0].ObjectValue()["resourceId"].StringValue())
+	assert.Equal(t, "orders_2023", matches[0].ObjectValue()["name"].StringValue())
+}
+
+func TestGetAssetSummary(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/summary", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"types":    map[string]int{"Table": 40, "Topic": 12},
+			"services": map[string]int{"PostgreSQL": 40, "Kafka": 12},
+			"tags":     map[string]int{"pii": 3, "owner-reviewed": 50},
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getAssetSummary", map[string]interface{}{
+		"minTagCounts": map[string]interface{}{"owner-reviewed": 50},
+	})
+	require.Empty(t, response.Failures)
+	assert.Equal(t, 52.0, response.Return["total"].NumberValue())
+	assert.Equal(t, 12.0, response.Return["services"].ObjectValue()["Kafka"].NumberValue())
+	assert.Equal(t, 3.0, response.Return["tags"].ObjectValue()["pii"].NumberValue())
+
+	_, err := prov.Invoke(p.InvokeRequest{
+		Token: "marmot:index:getAssetSummary",
+		Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"minTypeCounts": map[string]interface{}{"Dashboard": 1},
+			"minTagCounts":  map[string]interface{}{"pii": 10},
+		}),
+	})
+	assert.ErrorContains(t, err, `type "Dashboard" has 0 assets, expected at least 1`)
+	assert.ErrorContains(t, err, `tag "pii" has 3 assets, expected at least 10`)
+}
+
+func TestGetLineage(t *testing.T) {
+	const assetID = "6f1c2a9e-4a4b-4a43-9d55-0a8e6b1f6c01"
+	dashboard := catalogAsset("dash-id", "dashboard", "looker", "revenue", nil)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/lineage/assets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, assetID, r.PathValue("id"))
+		assert.Equal(t, "downstream", r.URL.Query().Get("direction"))
+		assert.Equal(t, "3", r.URL.Query().Get("limit"))
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{"id": assetID, "depth": 0, "type": "table"},
+				{"id": "dash-id", "depth": 1, "type": "dashboard", "asset": dashboard},
+			},
+			"edges": []map[string]interface{}{
+				{"id": "edge-id", "source": "mrn://table/postgresql/orders", "target": dashboard["mrn"], "job_mrn": "mrn://job/airflow/load", "type": "DIRECT"},
+			},
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getLineage", map[string]interface{}{
+		"resourceId": assetID,
+		"direction":  "downstream",
+		"depth":      3,
+	})
+	require.Empty(t, response.Failures)
+
+	nodes := response.Return["nodes"].ArrayValue()
+	require.Len(t, nodes, 2)
+	assert.False(t, nodes[0].ObjectValue().HasValue("asset"))
+	assert.Equal(t, 1.0, nodes[1].ObjectValue()["depth"].NumberValue())
+	assert.Equal(t, "mrn://dashboard/looker/revenue", nodes[1].ObjectValue()["asset"].ObjectValue()["mrn"].StringValue())
+
+	edges := response.Return["edges"].ArrayValue()
+	require.Len(t, edges, 1)
+	assert.Equal(t, "mrn://job/airflow/load", edges[0].ObjectValue()["jobMrn"].StringValue())
+	assert.Equal(t, "edge-id", edges[0].ObjectValue()["resourceId"].StringValue())
+
+	_, err := prov.Invoke(p.InvokeRequest{
+		Token: "marmot:index:getLineage",
+		Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"resourceId": assetID,
+			"direction":  "sideways",
+		}),
+	})
+	assert.ErrorContains(t, err, `direction must be one of upstream, downstream or both, got "sideways"`)
+}
+
+func TestGetMetadataFieldSuggestions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/suggestions/metadata/fields", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, []map[string]interface{}{
+			{"field": "owner", "path_parts": []string{"owner"}, "type": "string", "types": []string{"string"}, "count": 40, "example": "platform-team"},
+			{"field": "retention.ms", "path_parts": []string{"retention", "ms"}, "type": "number", "types": []string{"number"}, "count": 12},
+			{"field": "retention.bytes", "path_parts": []string{"retention", "bytes"}, "type": "number", "types": []string{"number", "string"}, "count": 30},
+			{"field": "retention.policy", "path_parts": []string{"retention", "policy"}, "type": "string", "types": []string{"string"}, "count": 5},
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getMetadataFieldSuggestions", map[string]interface{}{
+		"prefix": "retention.",
+		"limit":  2,
+	})
+	require.Empty(t, response.Failures)
+
+	fields := response.Return["fields"].ArrayValue()
+	require.Len(t, fields, 2)
+	assert.Equal(t, "retention.bytes", fields[0].ObjectValue()["field"].StringValue())
+	assert.Equal(t, 30.0, fields[0].ObjectValue()["count"].NumberValue())
+	assert.Len(t, fields[0].ObjectValue()["types"].ArrayValue(), 2)
+	assert.Equal(t, "retention.ms", fields[1].ObjectValue()["field"].StringValue())
+	assert.Len(t, fields[1].ObjectValue()["pathParts"].ArrayValue(), 2)
+}
+
+func TestGetMetadataValueSuggestions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/suggestions/metadata/values", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "owner", r.URL.Query().Get("field"))
+		assert.Equal(t, "data", r.URL.Query().Get("prefix"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		writeJSON(t, w, http.StatusOK, []map[string]interface{}{
+			{"value": "data-platform", "count": 8, "example": catalogAsset("a", "table", "postgresql", "orders", nil)},
+			{"value": "data-science", "count": 2},
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getMetadataValueSuggestions", map[string]interface{}{
+		"field":  "owner",
+		"prefix": "data",
+		"limit":  5,
+	})
+	require.Empty(t, response.Failures)
+
+	values := response.Return["values"].ArrayValue()
+	require.Len(t, values, 2)
+	assert.Equal(t, "data-platform", values[0].ObjectValue()["value"].StringValue())
+	assert.Equal(t, 8.0, values[0].ObjectValue()["count"].NumberValue())
+	assert.Equal(t, "mrn://table/postgresql/orders", values[0].ObjectValue()["exampleMrn"].StringValue())
+	assert.Equal(t, "data-science", values[1].ObjectValue()["value"].StringValue())
+}
+
+func TestGetTagSuggestions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/suggestions/tags", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cust", r.URL.Query().Get("prefix"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		writeJSON(t, w, http.StatusOK, []string{"customer-data", "customer-facing"})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getTagSuggestions", map[string]interface{}{"prefix": "cust"})
+	require.Empty(t, response.Failures)
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("customer-data"),
+		resource.NewStringProperty("customer-facing"),
+	}), response.Return["tags"])
+}
+
+func TestGetCurrentUser(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test", r.Header.Get("X-API-Key"))
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"id":       "user-id",
+			"username": "pulumi-ci",
+			"name":     "Pulumi CI",
+			"active":   true,
+			"roles": []map[string]interface{}{{
+				"id":   "role-id",
+				"name": "admin",
+				"permissions": []map[string]interface{}{
+					{"id": "perm-1", "name": "assets:manage", "resource_type": "assets", "action": "manage"},
+				},
+			}},
+		})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getCurrentUser", nil)
+	require.Empty(t, response.Failures)
+	assert.Equal(t, "user-id", response.Return["resourceId"].StringValue())
+	assert.Equal(t, "pulumi-ci", response.Return["username"].StringValue())
+	assert.True(t, response.Return["active"].BoolValue())
+
+	roles := response.Return["roles"].ArrayValue()
+	require.Len(t, roles, 1)
+	assert.Equal(t, "admin", roles[0].ObjectValue()["name"].StringValue())
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("assets:manage"),
+	}), roles[0].ObjectValue()["permissions"])
+}
+
+func TestListUsersPaginates(t *testing.T) {
+	var offsets []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/users", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "jane", query.Get("query"))
+		assert.Equal(t, "true", query.Get("active"))
+		assert.Equal(t, "role-a,role-b", query.Get("role_ids"))
+		assert.Equal(t, "2", query.Get("limit"))
+		offsets = append(offsets, query.Get("offset"))
+
+		offset, err := strconv.Atoi(query.Get("offset"))
+		require.NoError(t, err)
+		page := []map[string]interface{}{}
+		for i := offset; i < min(offset+2, 3); i++ {
+			page = append(page, map[string]interface{}{
+				"id":       fmt.Sprintf("user-%d", i),
+				"username": fmt.Sprintf("jane%d", i),
+				"active":   true,
+			})
+		}
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"users": page, "total": 3})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "listUsers", map[string]interface{}{
+		"query":   "jane",
+		"active":  true,
+		"roleIds": []interface{}{"role-a", "role-b"},
+		"limit":   2,
+	})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, []string{"0", "2"}, offsets)
+	assert.Equal(t, 3.0, response.Return["total"].NumberValue())
+	users := response.Return["users"].ArrayValue()
+	require.Len(t, users, 3)
+	assert.Equal(t, "jane2", users[2].ObjectValue()["username"].StringValue())
+	assert.Empty(t, users[2].ObjectValue()["roles"].ArrayValue())
+}
+
+func TestGetAuthConfig(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/auth/config", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"enabled_providers": []string{"okta", "google"}})
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	response := invoke(t, prov, "getAuthConfig", nil)
+	require.Empty(t, response.Failures)
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("okta"),
+		resource.NewStringProperty("google"),
+	}), response.Return["enabledProviders"])
+}
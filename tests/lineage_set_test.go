@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func edge(source, target string) map[string]interface{} {
+	return map[string]interface{}{"source": source, "target": target, "jobMrn": "mrn://job/spark/etl"}
+}
+
+func edgeResult(source, target, id, status string) map[string]interface{} {
+	result := edge(source, target)
+	result["resourceId"] = id
+	result["status"] = status
+	result["type"] = "DIRECT"
+	return result
+}
+
+// serveLineageBatch fakes the batch lineage endpoint. Edges from "mrn://a" are
+// reported as already existing.
+func serveLineageBatch(t *testing.T, mux *http.ServeMux) *[][]map[string]interface{} {
+	var requests [][]map[string]interface{}
+	mux.HandleFunc("POST /api/v1/lineage/batch", func(w http.ResponseWriter, r *http.Request) {
+		var edges []map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&edges))
+		requests = append(requests, edges)
+
+		results := []map[string]interface{}{}
+		for i := len(edges) - 1; i >= 0; i-- {
+			e := edges[i]
+			status := "created"
+			if e["source"] == "mrn://a" {
+				status = "existing"
+			}
+			e["id"] = fmt.Sprintf("00000000-0000-0000-0000-%012d", i)
+			e["type"] = "DIRECT"
+			results = append(results, map[string]interface{}{"edge": e, "status": status})
+		}
+		writeJSON(t, w, http.StatusOK, results)
+	})
+	return &requests
+}
+
+func TestLineageSetCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	requests := serveLineageBatch(t, mux)
+	prov := configuredProvider(t, mux, nil)
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn: urn("LineageSet"),
+		Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"edges": []interface{}{edge("mrn://a", "mrn://t"), edge("mrn://b", "mrn://t")},
+		}),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	assert.Len(t, (*requests)[0], 2)
+	assert.Equal(t, "mrn://job/spark/etl", (*requests)[0][0]["job_mrn"])
+
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewObjectProperty(resource.NewPropertyMapFromMap(edgeResult("mrn://a", "mrn://t", "00000000-0000-0000-0000-000000000000", "existing"))),
+		resource.NewObjectProperty(resource.NewPropertyMapFromMap(edgeResult("mrn://b", "mrn://t", "00000000-0000-0000-0000-000000000001", "created"))),
+	}), response.Properties["results"])
+}
+
+func TestLineageSetDiffByContent(t *testing.T) {
+	prov := provider()
+
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"edges":   []interface{}{edge("mrn://a", "mrn://t"), edge("mrn://b", "mrn://t"), edge("mrn://c", "mrn://t")},
+		"results": []interface{}{},
+	})
+	news := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"edges": []interface{}{edge("mrn://c", "mrn://t"), edge("mrn://a", "mrn://t"), edge("mrn://d", "mrn://t")},
+	})
+
+	// Reordering edges is not a change; only the removed and added edges are
+	// reported.
+	response, err := prov.Diff(p.DiffRequest{ID: "spark", Urn: urn("LineageSet"), Olds: olds, News: news})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"edges[1]": {Kind: p.Delete},
+		"edges[2]": {Kind: p.Add},
+	}, response.DetailedDiff)
+}
+
+func TestLineageSetUpdate(t *testing.T) {
+	var deleted []string
+	mux := http.NewServeMux()
+	requests := serveLineageBatch(t, mux)
+	mux.HandleFunc("DELETE /api/v1/lineage/direct/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, r.PathValue("id"))
+		w.WriteHeader(http.StatusOK)
+	})
+	prov := configuredProvider(t, mux, nil)
+
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"edges": []interface{}{edge("mrn://a", "mrn://t"), edge("mrn://b", "mrn://t"), edge("mrn://c", "mrn://t")},
+		"results": []interface{}{
+			edgeResult("mrn://a", "mrn://t", "11111111-1111-1111-1111-111111111111", "existing"),
+			edgeResult("mrn://b", "mrn://t", "22222222-2222-2222-2222-222222222222", "created"),
+			edgeResult("mrn://c", "mrn://t", "33333333-3333-3333-3333-333333333333", "created"),
+		},
+	})
+
+	response, err := prov.Update(p.UpdateRequest{
+		ID:   "spark",
+		Urn:  urn("LineageSet"),
+		Olds: olds,
+		News: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"edges": []interface{}{edge("mrn://c", "mrn://t"), edge("mrn://d", "mrn://t")},
+		}),
+	})
+	require.NoError(t, err)
+
+	// Only the new edge is created, and only the removed edge this set created
+	// is deleted.
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "mrn://d", (*requests)[0][0]["source"])
+	assert.Equal(t, []string{"22222222-2222-2222-2222-222222222222"}, deleted)
+
+	results := response.Properties["results"].ArrayValue()
+	require.Len(t, results, 2)
+	assert.Equal(t, "mrn://c", results[0].ObjectValue()["source"].StringValue())
+	assert.Equal(t, "mrn://d", results[1].ObjectValue()["source"].StringValue())
+	assert.Equal(t, "created", results[1].ObjectValue()["status"].StringValue())
+}
+
+func TestLineageSetCheckRejectsDuplicates(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("LineageSet"),
+		News: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"edges": []interface{}{edge("mrn://a", "mrn://t"), edge("mrn://a", "mrn://t")},
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []p.CheckFailure{{
+		Property: "edges[1]",
+		Reason:   "edge mrn://a -> mrn://t (mrn://job/spark/etl) is declared more than once",
+	}}, response.Failures)
+}
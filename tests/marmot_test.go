@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configuredProvider starts a fake Marmot API backed by handler and returns a
+// provider configured against it. Extra config values are merged in.
+func configuredProvider(t *testing.T, handler http.Handler, config map[string]interface{}) integration.Server {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	args := map[string]interface{}{
+		"host":   server.URL,
+		"apiKey": "test",
+	}
+	for k, v := range config {
+		args[k] = v
+	}
+
+	prov := provider()
+	require.NoError(t, prov.Configure(p.ConfigureRequest{
+		Args: resource.NewPropertyMapFromMap(args),
+	}))
+	return prov
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func TestConfigureFailFast(t *testing.T) {
+	configure := func(host, apiKey string) error {
+		return provider().Configure(p.ConfigureRequest{
+			Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"host":     host,
+				"apiKey":   apiKey,
+				"failFast": true,
+			}),
+		})
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/auth/config", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"enabled_providers": []string{"okta"}})
+	})
+	mux.HandleFunc("GET /api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != "valid" {
+			writeJSON(t, w, http.StatusUnauthorized, map[string]interface{}{"error": "invalid API key"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"id": "user-id", "username": "pulumi-ci"})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, configure(server.URL, "valid"))
+	})
+
+	t.Run("bad api key", func(t *testing.T) {
+		err := configure(server.URL, "wrong")
+		assert.EqualError(t, err, "the configured apiKey was rejected by Marmot at "+server.URL)
+	})
+
+	t.Run("unreachable host", func(t *testing.T) {
+		closed := httptest.NewServer(http.NotFoundHandler())
+		closed.Close()
+
+		err := configure(closed.URL, "valid")
+		assert.ErrorContains(t, err, "cannot reach Marmot at "+closed.URL)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		closed := httptest.NewServer(http.NotFoundHandler())
+		closed.Close()
+
+		err := provider().Configure(p.ConfigureRequest{
+			Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"host":   closed.URL,
+				"apiKey": "valid",
+			}),
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestConfigureRejectsInvalidStrictTags(t *testing.T) {
+	err := provider().Configure(p.ConfigureRequest{
+		Args: resource.NewPropertyMapFromMap(map[string]interface{}{
+			"host":       "http://localhost:8080",
+			"apiKey":     "test",
+			"strictTags": "strict",
+		}),
+	})
+	assert.ErrorContains(t, err, `strictTags must be "warn" or "error", got "strict"`)
+}
@@ -0,0 +1,449 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func writeSchemaFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func checkAssetSchema(t *testing.T, prov integration.Server, schema map[string]interface{}) p.CheckResponse {
+	inputs := map[string]interface{}{
+		"name":     "orders",
+		"type":     "Topic",
+		"services": []interface{}{"Kafka"},
+	}
+	for k, v := range schema {
+		inputs[k] = v
+	}
+	response, err := prov.Check(p.CheckRequest{
+		Urn:  urn("Asset"),
+		News: resource.NewPropertyMapFromMap(inputs),
+	})
+	require.NoError(t, err)
+	return response
+}
+
+const orderAvroSchema = `{
+  "type": "record",
+  "name": "Order",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID"]}},
+    {"name": "previous_status", "type": ["null", "Status"], "default": null},
+    {"name": "total", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+    {"name": "placed_at", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "notes", "type": {"type": "array", "items": {"type": "string"}}}
+  ]
+}`
+
+func TestAssetCheckAvroSchemaFile(t *testing.T) {
+	prov := provider()
+	path := writeSchemaFile(t, "order.avsc", orderAvroSchema)
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"type":      "record",
+		"name":      "Order",
+		"namespace": "com.example",
+		"fields": []interface{}{
+			map[string]interface{}{"name": "id", "type": map[string]interface{}{"type": "string", "logicalType": "uuid"}},
+			map[string]interface{}{"name": "status", "type": map[string]interface{}{
+				"type": "enum", "name": "Status", "namespace": "com.example", "symbols": []interface{}{"NEW", "PAID"},
+			}},
+			map[string]interface{}{"name": "previous_status", "type": []interface{}{"null", "com.example.Status"}, "default": nil},
+			map[string]interface{}{"name": "total", "type": map[string]interface{}{
+				"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2,
+			}},
+			map[string]interface{}{"name": "placed_at", "type": map[string]interface{}{"type": "long", "logicalType": "timestamp-millis"}},
+			map[string]interface{}{"name": "notes", "type": map[string]interface{}{"type": "array", "items": "string"}},
+		},
+	}), response.Inputs["schema"].ObjectValue())
+}
+
+func TestAssetCheckAvroSchemaInvalid(t *testing.T) {
+	prov := provider()
+
+	tests := map[string]struct {
+		schema string
+		reason string
+	}{
+		"unknown type": {
+			`{"type": "record", "name": "Order", "fields": [{"name": "customer", "type": "Customer"}]}`,
+			`invalid avro schema: field "customer": unknown type "Customer"`,
+		},
+		"duplicate union branch": {
+			`{"type": "record", "name": "Order", "fields": [{"name": "id", "type": ["string", "string"]}]}`,
+			`invalid avro schema: field "id": union contains "string" more than once`,
+		},
+		"duplicate enum symbol": {
+			`{"type": "enum", "name": "Status", "symbols": ["NEW", "NEW"]}`,
+			`invalid avro schema: enum "Status": symbol "NEW" is defined more than once`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeSchemaFile(t, "schema.avsc", tt.schema)
+			response := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path})
+			assert.Equal(t, []p.CheckFailure{{Property: "schemaFile", Reason: tt.reason}}, response.Failures)
+		})
+	}
+}
+
+func TestAssetCheckAvroSchemaIgnoresInvalidLogicalTypes(t *testing.T) {
+	prov := provider()
+	path := writeSchemaFile(t, "order.avsc", `{
+  "type": "record",
+  "name": "Order",
+  "fields": [
+    {"name": "day", "type": {"type": "string", "logicalType": "date"}},
+    {"name": "region", "type": {"type": "string", "logicalType": "country-code"}},
+    {"name": "total", "type": {"type": "bytes", "logicalType": "decimal", "precision": 4, "scale": 6}},
+    {"name": "amount", "type": {"type": "fixed", "name": "Amount", "size": 2, "logicalType": "decimal", "precision": 10}},
+    {"name": "id", "type": {"type": "fixed", "name": "ID", "size": 8, "logicalType": "uuid"}},
+    {"name": "ttl", "type": {"type": "fixed", "name": "TTL", "size": 4, "logicalType": "duration"}}
+  ]
+}`)
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"type": "record",
+		"name": "Order",
+		"fields": []interface{}{
+			map[string]interface{}{"name": "day", "type": "string"},
+			map[string]interface{}{"name": "region", "type": "string"},
+			map[string]interface{}{"name": "total", "type": "bytes"},
+			map[string]interface{}{"name": "amount", "type": map[string]interface{}{"type": "fixed", "name": "Amount", "size": 2}},
+			map[string]interface{}{"name": "id", "type": map[string]interface{}{"type": "fixed", "name": "ID", "size": 8}},
+			map[string]interface{}{"name": "ttl", "type": map[string]interface{}{"type": "fixed", "name": "TTL", "size": 4}},
+		},
+	}), response.Inputs["schema"].ObjectValue())
+}
+
+func TestAssetCheckSchemaFileConflicts(t *testing.T) {
+	prov := provider()
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{
+		"schemaFile": writeSchemaFile(t, "order.avsc", orderAvroSchema),
+		"schema":     map[string]interface{}{"type": "record"},
+	})
+	assert.Equal(t, []p.CheckFailure{{Property: "schemaFile", Reason: "schema and schemaFile cannot both be set"}}, response.Failures)
+
+	response = checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": writeSchemaFile(t, "order.txt", orderAvroSchema)})
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "schemaFormat", response.Failures[0].Property)
+}
+
+func TestAssetDiffAvroSchemaFile(t *testing.T) {
+	prov := provider()
+	path := writeSchemaFile(t, "order.avsc", orderAvroSchema)
+	olds := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path}).Inputs
+
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "type": "record",
+  "name": "Order",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID", "SHIPPED"]}},
+    {"name": "previous_status", "type": ["null", "Status"], "default": null},
+    {"name": "total", "type": {"type": "bytes", "logicalType": "decimal", "precision": 12, "scale": 2}},
+    {"name": "placed_at", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "notes", "type": {"type": "array", "items": {"type": "string"}}}
+  ]
+}`), 0o644))
+	news := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path}).Inputs
+
+	response, err := prov.Diff(p.DiffRequest{
+		ID:   "asset-id",
+		Urn:  urn("Asset"),
+		Olds: assetState(olds),
+		News: news,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"schema.fields[1].type.symbols[2]": {Kind: p.Add},
+		"schema.fields[3].type.precision":  {Kind: p.Update},
+	}, response.DetailedDiff)
+}
+
+func TestAssetCheckJSONSchemaInline(t *testing.T) {
+	prov := provider()
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{
+		"schemaFormat": "jsonschema",
+		"schema": map[string]interface{}{
+			"$schema":  "http://json-schema.org/draft-07/schema#",
+			"type":     "object",
+			"required": []interface{}{"id", "shipping"},
+			"properties": map[string]interface{}{
+				"id":       map[string]interface{}{"type": "string", "format": "uuid"},
+				"shipping": map[string]interface{}{"$ref": "#/definitions/address", "description": "Where the order is shipped"},
+			},
+			"definitions": map[string]interface{}{
+				"address": map[string]interface{}{
+					"type":        "object",
+					"description": "A postal address",
+					"required":    []interface{}{"city"},
+					"properties": map[string]interface{}{
+						"city": map[string]interface{}{"type": "string"},
+						"zip":  map[string]interface{}{"type": []interface{}{"string", "null"}},
+					},
+				},
+			},
+		},
+	})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"$schema":  "http://json-schema.org/draft-07/schema#",
+		"type":     "object",
+		"required": []interface{}{"id", "shipping"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{"type": "string", "format": "uuid"},
+			// Draft-07 ignores the keywords next to $ref.
+			"shipping": map[string]interface{}{
+				"type":        "object",
+				"description": "A postal address",
+				"required":    []interface{}{"city"},
+				"properties": map[string]interface{}{
+					"city": map[string]interface{}{"type": "string"},
+					"zip":  map[string]interface{}{"type": []interface{}{"string", "null"}},
+				},
+			},
+		},
+	}), response.Inputs["schema"].ObjectValue())
+}
+
+func TestAssetCheckJSONSchemaMergesRefSiblings(t *testing.T) {
+	prov := provider()
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{
+		"schemaFormat": "jsonschema",
+		"schema": map[string]interface{}{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type":    "object",
+			"properties": map[string]interface{}{
+				"shipping": map[string]interface{}{"$ref": "#/$defs/address", "description": "Where the order is shipped"},
+				// Properties named like keywords that hold values are schemas.
+				"default": map[string]interface{}{"$ref": "#/$defs/address"},
+				"enum":    map[string]interface{}{"$ref": "#/$defs/address", "default": map[string]interface{}{"$ref": "kept"}},
+			},
+			"$defs": map[string]interface{}{
+				"address": map[string]interface{}{"type": "object", "description": "A postal address"},
+			},
+		},
+	})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"shipping": map[string]interface{}{"type": "object", "description": "Where the order is shipped"},
+			"default":  map[string]interface{}{"type": "object", "description": "A postal address"},
+			"enum": map[string]interface{}{
+				"type":        "object",
+				"description": "A postal address",
+				"default":     map[string]interface{}{"$ref": "kept"},
+			},
+		},
+	}), response.Inputs["schema"].ObjectValue())
+}
+
+func TestAssetCheckJSONSchemaFileKeepsRecursiveRefs(t *testing.T) {
+	prov := provider()
+	path := writeSchemaFile(t, "category.json", `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/category",
+  "$defs": {
+    "category": {
+      "type": "object",
+      "properties": {
+        "name": {"type": "string"},
+        "children": {"type": "array", "items": {"$ref": "#/$defs/category"}}
+      }
+    }
+  }
+}`)
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path})
+	require.Empty(t, response.Failures)
+
+	schema := response.Inputs["schema"].ObjectValue()
+	assert.Equal(t, "object", schema["type"].StringValue())
+	assert.Contains(t, schema, resource.PropertyKey("$defs"))
+	children := schema["properties"].ObjectValue()["children"].ObjectValue()
+	assert.Equal(t, "#/$defs/category", children["items"].ObjectValue()["$ref"].StringValue())
+}
+
+func TestAssetCheckJSONSchemaInvalid(t *testing.T) {
+	prov := provider()
+
+	tests := map[string]struct {
+		schema map[string]interface{}
+		reason string
+	}{
+		"unknown type": {
+			map[string]interface{}{"type": "object", "properties": map[string]interface{}{"id": map[string]interface{}{"type": "uuid"}}},
+			`invalid jsonschema schema: /properties/id/type: expected array, but got string; /properties/id/type: value must be one of "array", "boolean", "integer", "null", "number", "object", "string"`,
+		},
+		"missing ref": {
+			map[string]interface{}{"properties": map[string]interface{}{"id": map[string]interface{}{"$ref": "#/$defs/id"}}},
+			`invalid jsonschema schema: $ref "#/$defs/id" cannot be resolved`,
+		},
+		"remote ref": {
+			map[string]interface{}{"properties": map[string]interface{}{"id": map[string]interface{}{"$ref": "common.json#/$defs/id"}}},
+			`invalid jsonschema schema: $ref "common.json#/$defs/id" is not local, only references within the document are supported`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := checkAssetSchema(t, prov, map[string]interface{}{"schemaFormat": "jsonschema", "schema": tt.schema})
+			assert.Equal(t, []p.CheckFailure{{Property: "schema", Reason: tt.reason}}, response.Failures)
+		})
+	}
+}
+
+func TestAssetCheckProtobufSchemaFile(t *testing.T) {
+	prov := provider()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "common.proto"), []byte(`syntax = "proto3";
+package shop.common;
+
+message Address {
+  string city = 1;
+  optional string zip = 2;
+}
+`), 0o644))
+	path := filepath.Join(dir, "order.proto")
+	require.NoError(t, os.WriteFile(path, []byte(`syntax = "proto3";
+package shop.orders;
+
+import "common.proto";
+import "google/protobuf/timestamp.proto";
+
+message Order {
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_PAID = 1;
+  }
+  message Line {
+    string sku = 1;
+    int32 quantity = 2;
+  }
+
+  // Unique order identifier.
+  string id = 1;
+  Status status = 2;
+  repeated Line lines = 3;
+  map<string, shop.common.Address> addresses = 4;
+  oneof payment {
+    string card_token = 5;
+    string invoice_id = 6;
+  }
+  google.protobuf.Timestamp placed_at = 7;
+  Order parent = 8;
+}
+
+message Refund {
+  string order_id = 1;
+}
+`), 0o644))
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{
+		"schemaFile":    path,
+		"schemaMessage": "shop.orders.Order",
+		"metadata":      map[string]interface{}{"owner": "orders-team"},
+	})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"type":    "protobuf",
+		"message": "shop.orders.Order",
+		"package": "shop.orders",
+		"syntax":  "proto3",
+		"fields": []interface{}{
+			map[string]interface{}{"name": "id", "number": 1, "type": "string", "doc": "Unique order identifier."},
+			map[string]interface{}{"name": "status", "number": 2, "type": "shop.orders.Order.Status", "symbols": []interface{}{"STATUS_UNSPECIFIED", "STATUS_PAID"}},
+			map[string]interface{}{"name": "lines", "number": 3, "type": "shop.orders.Order.Line", "label": "repeated"},
+			map[string]interface{}{"name": "lines.sku", "number": 1, "type": "string"},
+			map[string]interface{}{"name": "lines.quantity", "number": 2, "type": "int32"},
+			map[string]interface{}{
+				"name": "addresses", "number": 4, "type": "map<string, shop.common.Address>",
+				"keyType": "string", "valueType": "shop.common.Address",
+			},
+			map[string]interface{}{"name": "addresses.value.city", "number": 1, "type": "string"},
+			map[string]interface{}{"name": "addresses.value.zip", "number": 2, "type": "string", "label": "optional"},
+			map[string]interface{}{"name": "card_token", "number": 5, "type": "string", "oneof": "payment"},
+			map[string]interface{}{"name": "invoice_id", "number": 6, "type": "string", "oneof": "payment"},
+			map[string]interface{}{"name": "placed_at", "number": 7, "type": "google.protobuf.Timestamp"},
+			map[string]interface{}{"name": "parent", "number": 8, "type": "shop.orders.Order"},
+		},
+	}), response.Inputs["schema"].ObjectValue())
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"owner":         "orders-team",
+		"proto_package": "shop.orders",
+		"proto_syntax":  "proto3",
+	}), response.Inputs["metadata"].ObjectValue())
+
+	response = checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path})
+	assert.Equal(t, []p.CheckFailure{{
+		Property: "schemaFile",
+		Reason:   "invalid protobuf schema: set schemaMessage to one of shop.orders.Order, shop.orders.Refund",
+	}}, response.Failures)
+}
+
+func TestAssetCheckProtobufDescriptorSet(t *testing.T) {
+	prov := provider()
+	set, err := proto.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("event.proto"),
+			Package: proto.String("events"),
+			Syntax:  proto.String("proto2"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("Event"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:   proto.String("id"),
+					Number: proto.Int32(1),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum(),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+				}},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "events.binpb")
+	require.NoError(t, os.WriteFile(path, set, 0o644))
+
+	response := checkAssetSchema(t, prov, map[string]interface{}{"schemaFile": path})
+	require.Empty(t, response.Failures)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"type":    "protobuf",
+		"message": "events.Event",
+		"package": "events",
+		"syntax":  "proto2",
+		"fields": []interface{}{
+			map[string]interface{}{"name": "id", "number": 1, "type": "int64", "label": "required"},
+		},
+	}), response.Inputs["schema"].ObjectValue())
+}
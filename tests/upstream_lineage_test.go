@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	upstreamTarget   = "mrn://table/postgresql/orders"
+	upstreamTargetID = "6f1c2a9e-4a4b-4a43-9d55-0a8e6b1f6c01"
+)
+
+// upstreamServer fakes a catalog in which the target has upstream edges from
+// a declared source and from a hand-drawn one.
+type upstreamServer struct {
+	created []map[string]interface{}
+	deleted []string
+}
+
+func (s *upstreamServer) mux(t *testing.T) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/assets/qualified-name/{mrn...}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, upstreamTarget, r.PathValue("mrn"))
+		writeJSON(t, w, http.StatusOK, catalogAsset(upstreamTargetID, "table", "postgresql", "orders", nil))
+	})
+	mux.HandleFunc("GET /api/v1/lineage/assets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, upstreamTargetID, r.PathValue("id"))
+		assert.Equal(t, "upstream", r.URL.Query().Get("direction"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"nodes": []map[string]interface{}{},
+			"edges": []map[string]interface{}{
+				{"id": "11111111-1111-1111-1111-111111111111", "source": "mrn://topic/kafka/orders", "target": upstreamTarget, "type": "DIRECT"},
+				{"id": "22222222-2222-2222-2222-222222222222", "source": "mrn://table/postgresql/scratch", "target": upstreamTarget, "type": "DIRECT"},
+				{"id": "55555555-5555-5555-5555-555555555555", "source": "mrn://table/postgresql/scratch", "target": upstreamTarget, "type": "DIRECT", "job_mrn": "mrn://job/airflow/backfill"},
+				{"id": "33333333-3333-3333-3333-333333333333", "source": "mrn://topic/kafka/raw", "target": "mrn://topic/kafka/orders", "type": "DIRECT"},
+			},
+		})
+	})
+	mux.HandleFunc("POST /api/v1/lineage/direct", func(w http.ResponseWriter, r *http.Request) {
+		var edge map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&edge))
+		s.created = append(s.created, edge)
+		edge["id"] = "44444444-4444-4444-4444-444444444444"
+		writeJSON(t, w, http.StatusOK, edge)
+	})
+	mux.HandleFunc("DELETE /api/v1/lineage/direct/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.deleted = append(s.deleted, r.PathValue("id"))
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+func upstreamInputs(sources ...interface{}) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"target":  upstreamTarget,
+		"sources": sources,
+	})
+}
+
+func TestUpstreamLineageCreate(t *testing.T) {
+	server := &upstreamServer{}
+	prov := configuredProvider(t, server.mux(t), nil)
+
+	response, err := prov.Create(p.CreateRequest{
+		Urn:        urn("UpstreamLineage"),
+		Properties: upstreamInputs("mrn://topic/kafka/orders", "mrn://table/postgresql/customers"),
+	})
+	require.NoError(t, err)
+
+	// The hand-drawn edge is removed, the declared one kept and the missing one
+	// created.
+	assert.Equal(t, []string{"22222222-2222-2222-2222-222222222222", "55555555-5555-5555-5555-555555555555"}, server.deleted)
+	require.Len(t, server.created, 1)
+	assert.Equal(t, "mrn://table/postgresql/customers", server.created[0]["source"])
+	assert.Equal(t, upstreamTarget, server.created[0]["target"])
+
+	assert.Equal(t, upstreamTargetID, response.Properties["targetId"].StringValue())
+	assert.Equal(t, resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"mrn://table/postgresql/customers": map[string]interface{}{
+			"source":     "mrn://table/postgresql/customers",
+			"resourceId": "44444444-4444-4444-4444-444444444444",
+			"jobMrn":     "",
+			"created":    true,
+		},
+		"mrn://topic/kafka/orders": map[string]interface{}{
+			"source":     "mrn://topic/kafka/orders",
+			"resourceId": "11111111-1111-1111-1111-111111111111",
+			"jobMrn":     "",
+			"created":    false,
+		},
+	})), response.Properties["edges"])
+}
+
+func TestUpstreamLineagePreviewReportsRemovals(t *testing.T) {
+	server := &upstreamServer{}
+	prov := configuredProvider(t, server.mux(t), nil)
+
+	olds := upstreamInputs("mrn://topic/kafka/orders")
+	olds["targetId"] = resource.NewStringProperty(upstreamTargetID)
+	olds["edges"] = resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"mrn://topic/kafka/orders": map[string]interface{}{
+			"source":     "mrn://topic/kafka/orders",
+			"resourceId": "11111111-1111-1111-1111-111111111111",
+		},
+	}))
+
+	// The declared sources are unchanged, but each edge drawn outside of
+	// Pulumi is reported as a change, keyed by its source and job.
+	diff, err := prov.Diff(p.DiffRequest{
+		ID:   "orders-upstream",
+		Urn:  urn("UpstreamLineage"),
+		Olds: olds,
+		News: upstreamInputs("mrn://topic/kafka/orders"),
+	})
+	require.NoError(t, err)
+	assert.True(t, diff.HasChanges)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		`edges["mrn://table/postgresql/scratch"]`:                            {Kind: p.Delete},
+		`edges["mrn://table/postgresql/scratch|mrn://job/airflow/backfill"]`: {Kind: p.Delete},
+	}, diff.DetailedDiff)
+
+	response, err := prov.Update(p.UpdateRequest{
+		ID:      "orders-upstream",
+		Urn:     urn("UpstreamLineage"),
+		Olds:    olds,
+		News:    upstreamInputs("mrn://topic/kafka/orders"),
+		Preview: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, server.deleted)
+	assert.Empty(t, server.created)
+	assert.Len(t, response.Properties["edges"].ObjectValue(), 1)
+}
+
+func TestUpstreamLineageDeleteKeepsAdoptedEdges(t *testing.T) {
+	server := &upstreamServer{}
+	prov := configuredProvider(t, server.mux(t), nil)
+
+	state := upstreamInputs("mrn://topic/kafka/orders", "mrn://table/postgresql/customers")
+	state["targetId"] = resource.NewStringProperty(upstreamTargetID)
+	state["edges"] = resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"mrn://table/postgresql/customers": map[string]interface{}{
+			"source":     "mrn://table/postgresql/customers",
+			"resourceId": "44444444-4444-4444-4444-444444444444",
+			"created":    true,
+		},
+		"mrn://topic/kafka/orders": map[string]interface{}{
+			"source":     "mrn://topic/kafka/orders",
+			"resourceId": "11111111-1111-1111-1111-111111111111",
+		},
+	}))
+
+	// The edge from orders existed before the resource adopted it, so only the
+	// one it created is removed.
+	err := prov.Delete(p.DeleteRequest{
+		ID:         "orders-upstream",
+		Urn:        urn("UpstreamLineage"),
+		Properties: state,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"44444444-4444-4444-4444-444444444444"}, server.deleted)
+}
+
+func TestUpstreamLineageUpdateKeepsCreatedEdges(t *testing.T) {
+	server := &upstreamServer{}
+	prov := configuredProvider(t, server.mux(t), nil)
+
+	olds := upstreamInputs("mrn://topic/kafka/orders")
+	olds["targetId"] = resource.NewStringProperty(upstreamTargetID)
+	olds["edges"] = resource.NewObjectProperty(resource.NewPropertyMapFromMap(map[string]interface{}{
+		"mrn://topic/kafka/orders": map[string]interface{}{
+			"source":     "mrn://topic/kafka/orders",
+			"resourceId": "11111111-1111-1111-1111-111111111111",
+			"created":    true,
+		},
+	}))
+
+	response, err := prov.Update(p.UpdateRequest{
+		ID:   "orders-upstream",
+		Urn:  urn("UpstreamLineage"),
+		Olds: olds,
+		News: upstreamInputs("mrn://topic/kafka/orders"),
+	})
+	require.NoError(t, err)
+	edges := response.Properties["edges"].ObjectValue()
+	require.Len(t, edges, 1)
+	assert.True(t, edges["mrn://topic/kafka/orders"].ObjectValue()["created"].BoolValue())
+}